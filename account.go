@@ -1,61 +1,71 @@
 package pananames
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 // Represents a balance info
 type Balance struct {
-	Balance float64 `json:"balance"`
+	Balance Money `json:"balance"`
 }
 
 // Represents a payment info
 type Payment struct {
-	TxID       string  `json:"txid"`
-	TxDate     *PnTime `json:"txdate"`
-	TxType     string  `json:"txtype"`
-	Domain     string  `json:"domain"`
-	Period     string  `json:"period"`
-	Descripton string  `json:"description"`
-	Total      float64 `json:"total"`
+	TxID       string      `json:"txid"`
+	TxDate     *PnTime     `json:"txdate"`
+	TxType     PaymentType `json:"txtype"`
+	Domain     string      `json:"domain"`
+	Period     string      `json:"period"`
+	Descripton string      `json:"description"`
+	Total      Money       `json:"total"`
 }
 
 // Available options for GetAccountPayments()
 type GetAccountPaymentsOptions struct {
 	ListOptions
-	ID         int    `url:"id"`
-	DomainLike string `url:"domain_like,omitempty"`
-	PayType    string `url:"pay_type"`
-	DateFrom   string `url:"date_from"`
-	DateEnd    string `url:"date_end"`
+	ID         int         `url:"id,omitempty"`
+	DomainLike string      `url:"domain_like,omitempty"`
+	PayType    PaymentType `url:"pay_type,omitempty"`
+	DateFrom   string      `url:"date_from,omitempty"`
+	DateEnd    string      `url:"date_end,omitempty"`
+}
+
+// Validate GetAccountPaymentsOptions filters
+func (opt *GetAccountPaymentsOptions) Validate() error {
+	if opt == nil {
+		return nil
+	}
+	var e fieldErrors
+	e.list(opt.ListOptions)
+	e.date("date_from", opt.DateFrom)
+	e.date("date_end", opt.DateEnd)
+	return e.err(opt)
 }
 
 // Get current balance
 func (c *Client) GetAccountBalance(options ...RequestOptionFunc) (*Balance, error) {
-	u := "account/balance"
-
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetAccountBalanceCtx(context.Background(), options...)
+}
 
-	result := new(Balance)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+// Same as GetAccountBalance() with the request context
+func (c *Client) GetAccountBalanceCtx(ctx context.Context, options ...RequestOptionFunc) (*Balance, error) {
+	u := "account/balance"
 
-	return result, nil
+	options = append([]RequestOptionFunc{operation("GetAccountBalance", "")}, options...)
+	return Call[*Balance](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Get paged list of payments from your account
 func (c *Client) GetAccountPayments(opt *GetAccountPaymentsOptions, options ...RequestOptionFunc) ([]*Payment, *Pagination, error) {
-	u := "account/payments"
-	req, err := c.NewRequest(http.MethodGet, u, opt, options)
-	if err != nil {
-		return nil, nil, err
-	}
+	return c.GetAccountPaymentsCtx(context.Background(), opt, options...)
+}
 
-	var result []*Payment
-	resp, err := c.Do(req, &result)
+// Same as GetAccountPayments() with the request context
+func (c *Client) GetAccountPaymentsCtx(ctx context.Context, opt *GetAccountPaymentsOptions, options ...RequestOptionFunc) ([]*Payment, *Pagination, error) {
+	u := "account/payments"
+	options = append([]RequestOptionFunc{operation("GetAccountPayments", "")}, options...)
+	result, resp, err := call[[]*Payment](c, ctx, http.MethodGet, u, opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -15,7 +15,7 @@ func TestGetAccountBalance(t *testing.T) {
 		require.Equal(t, http.MethodGet, r.Method)
 		writeFixture(t, w, "balance.json")
 	})
-	want := &Balance{12.34}
+	want := &Balance{MustParseMoney("12.34", "")}
 	got, err := client.GetAccountBalance()
 	require.NoError(t, err)
 	require.Equal(t, want, got)
@@ -36,7 +36,7 @@ func TestGetAccountPayments(t *testing.T) {
 			TxType: "create",
 			Domain: "test.com",
 			Period: "1",
-			Total:  -1.23,
+			Total:  MustParseMoney("-1.23", ""),
 		},
 	}
 	wantPage := &Pagination{Total: 1, Limit: 30, Page: 1, Pages: 1}
@@ -0,0 +1,34 @@
+package pananames
+
+import (
+	"context"
+)
+
+// Call Make API request and decode data of the response into T
+// The path is relative to the API base URL. The body is sent as JSON for POST, PUT and DELETE requests
+// and as URL query for other methods, it may be nil. Use struct{} as T for responses without data.
+// All client options and request options are applied like for the client methods
+func Call[T any](c *Client, ctx context.Context, method, path string, body interface{}, options ...RequestOptionFunc) (T, error) {
+	result, _, err := call[T](c, ctx, method, path, body, options)
+	return result, err
+}
+
+// Same as Call() returning the response envelope too
+func call[T any](c *Client, ctx context.Context, method, path string, body interface{}, options []RequestOptionFunc) (T, *Response, error) {
+	var result, zero T
+	var v interface{} = &result
+	if _, ok := v.(*struct{}); ok {
+		v = nil
+	}
+
+	req, err := c.NewRequestWithContext(ctx, method, path, body, options)
+	if err != nil {
+		return zero, nil, err
+	}
+	resp, err := c.Do(req, v)
+	if err != nil {
+		return zero, nil, err
+	}
+
+	return result, resp, nil
+}
@@ -0,0 +1,77 @@
+package pananames
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCall(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	type gift struct {
+		Code      string  `json:"code"`
+		ExpiresAt *PnTime `json:"expires_at"`
+	}
+	mux.HandleFunc(apiVerPath+"gifts/test.com", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "secret", r.Header.Get("SIGNATURE"))
+		require.Equal(t, "yes", r.Header.Get("X-Custom"))
+		require.Equal(t, `{"amount":5}`, getBody(t, r))
+		w.Write([]byte(`{"data":{"code":"GIFT","expires_at":""}}`))
+	})
+
+	withHeader := func(req *http.Request) error {
+		req.Header.Set("X-Custom", "yes")
+		return nil
+	}
+	got, err := Call[*gift](client, context.Background(), http.MethodPost, "gifts/test.com", map[string]int{"amount": 5}, withHeader)
+	require.NoError(t, err)
+	require.Equal(t, &gift{Code: "GIFT"}, got)
+}
+
+func TestCallQuery(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"gifts", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "test.com", r.URL.Query().Get("domain"))
+		w.Write([]byte(`{"data":["GIFT1","GIFT2"]}`))
+	})
+
+	opt := struct {
+		Domain string `url:"domain"`
+	}{"test.com"}
+	got, err := Call[[]string](client, context.Background(), http.MethodGet, "gifts", opt)
+	require.NoError(t, err)
+	require.Equal(t, []string{"GIFT1", "GIFT2"}, got)
+}
+
+func TestCallNoData(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"gifts/test.com", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+	})
+
+	_, err := Call[struct{}](client, context.Background(), http.MethodDelete, "gifts/test.com", nil)
+	require.NoError(t, err)
+}
+
+func TestCallError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"gifts/test.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":404,"message":"Not found"}]}`))
+	})
+
+	got, err := Call[*Domain](client, context.Background(), http.MethodGet, "gifts/test.com", nil)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Nil(t, got)
+}
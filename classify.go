@@ -0,0 +1,142 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+)
+
+// IsTemporary reports whether err is caused by a transient condition:
+// network failure, timeout, server error or throttling. The same call may succeed later
+// Validation and other 4xx errors, decode errors and canceled requests are permanent
+func IsTemporary(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) {
+		return false
+	}
+	if code := errorStatusCode(err); code != 0 {
+		return temporaryStatus(code)
+	}
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+	return isNetworkError(err)
+}
+
+// IsRetryable reports whether the failed request is temporary and can be safely sent again
+// Non-idempotent requests, like RegisterDomain() or RenewDomain(), are retryable
+// only if the error guarantees the request wasn't processed by the API
+func IsRetryable(err error) bool {
+	if !IsTemporary(err) {
+		return false
+	}
+	if notProcessed(err) {
+		return true
+	}
+	if req := failedRequest(err); req != nil {
+		return isIdempotent(req)
+	}
+	return true
+}
+
+// Check if HTTP status code means transient failure
+func temporaryStatus(code int) bool {
+	switch code {
+	case http.StatusRequestTimeout, http.StatusTooEarly, http.StatusTooManyRequests,
+		http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Check if error means the request was rejected before processing
+func notProcessed(err error) bool {
+	switch errorStatusCode(err) {
+	case http.StatusTooEarly, http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// Check if error means the API accepted the request but its response can't be decoded
+func acceptedResponse(err error) bool {
+	var decodeErr *DecodeError
+	return errors.As(err, &decodeErr) && decodeErr.StatusCode >= 200 && decodeErr.StatusCode < 300
+}
+
+// Check if error means the API definitely didn't perform the request
+func rejected(err error) bool {
+	if code := errorStatusCode(err); code >= 400 && code < 500 {
+		return true
+	}
+	return notProcessed(err)
+}
+
+// Check if error came from the API or the connection to it
+// Errors returned before sending the request, e.g. validation errors, are not
+func requestSent(err error) bool {
+	return failedRequest(err) != nil || acceptedResponse(err)
+}
+
+// Check if error is a network failure or timeout
+func isNetworkError(err error) bool {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if urlErr.Timeout() {
+			return true
+		}
+		err = urlErr.Err
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// Get HTTP status code of the error response, 0 if there was no response
+func errorStatusCode(err error) int {
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp.StatusCode()
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.Response != nil {
+		return statusErr.Response.StatusCode
+	}
+	return 0
+}
+
+// Get the request which caused the error, nil if unknown
+func failedRequest(err error) *http.Request {
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.Request
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.Response != nil {
+		return statusErr.Response.Request
+	}
+	var transportErr *transportError
+	if errors.As(err, &transportErr) {
+		return transportErr.req
+	}
+	return nil
+}
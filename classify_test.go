@@ -0,0 +1,100 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyAPIErrors(t *testing.T) {
+	tests := []struct {
+		status    int
+		body      string
+		temporary bool
+		retryable bool
+	}{
+		{http.StatusServiceUnavailable, `{"errors":[{"code":503,"message":"unavailable"}]}`, true, true},
+		{http.StatusTooManyRequests, `{"errors":[{"code":429,"message":"throttled"}]}`, true, true},
+		{http.StatusGatewayTimeout, `<html>gateway timeout</html>`, true, false},
+		{http.StatusInternalServerError, ``, true, false},
+		{http.StatusUnprocessableEntity, `{"errors":[{"code":422,"message":"Invalid period"}]}`, false, false},
+		{http.StatusNotFound, `{"errors":[{"code":404,"message":"Not found"}]}`, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.status), func(t *testing.T) {
+			mux, server, client := setup(t)
+			defer teardown(server)
+
+			mux.HandleFunc(apiVerPath+"domains/test.com/renew", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+			mux.HandleFunc(apiVerPath+"domains/test.com/auto_renew", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			// non-idempotent request
+			_, err := client.RenewDomain("test.com", &RenewDomainOptions{Period: "1"})
+			require.Error(t, err)
+			require.Equal(t, tt.temporary, IsTemporary(err))
+			require.Equal(t, tt.retryable, IsRetryable(err))
+
+			// idempotent request
+			_, err = client.EnableDomainAutoRenew("test.com")
+			require.Error(t, err)
+			require.Equal(t, tt.temporary, IsTemporary(err))
+			require.Equal(t, tt.temporary, IsRetryable(err))
+		})
+	}
+}
+
+func TestClassifyDecodeError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+
+	_, err := client.GetAccountBalance()
+	var decodeErr *DecodeError
+	require.True(t, errors.As(err, &decodeErr))
+	require.Equal(t, http.StatusOK, decodeErr.StatusCode)
+	require.False(t, IsTemporary(err))
+	require.False(t, IsRetryable(err))
+}
+
+func TestClassifyNetworkErrors(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+
+	client, err := NewClient("secret", WithBaseURL("http://"+addr))
+	require.NoError(t, err)
+
+	// connection refused, request never reached the server
+	_, err = client.RegisterDomain(newRegisterOptions("test.com"))
+	require.Error(t, err)
+	require.True(t, IsTemporary(err))
+	require.True(t, IsRetryable(err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.GetDomainCtx(ctx, "test.com")
+	require.False(t, IsTemporary(err))
+	require.False(t, IsRetryable(err))
+
+	require.True(t, IsTemporary(context.DeadlineExceeded))
+	require.True(t, IsTemporary(&net.DNSError{IsTimeout: true}))
+	require.False(t, IsTemporary(&net.DNSError{IsNotFound: true}))
+	require.False(t, IsTemporary(errors.New("unknown")))
+	require.False(t, IsTemporary(nil))
+}
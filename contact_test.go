@@ -0,0 +1,95 @@
+package pananames
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// contact passing validation
+var validContact = &Contact{
+	Name:    "John Doe",
+	Email:   "john@example.com",
+	Address: "1 Main St",
+	City:    "Springfield",
+	Zip:     "12345",
+	Country: "US",
+	Phone:   "+1.5551234567",
+}
+
+func TestContactNormalize(t *testing.T) {
+	c := &Contact{
+		Name:    "  John Doe ",
+		Email:   " john@example.com\n",
+		Address: "1 Main St",
+		City:    "Springfield ",
+		Country: " us",
+		Phone:   "(555) 123-4567",
+	}
+	require.NoError(t, c.Normalize())
+	require.Equal(t, &Contact{
+		Name:    "John Doe",
+		Email:   "john@example.com",
+		Address: "1 Main St",
+		City:    "Springfield",
+		Country: "US",
+		Phone:   "+1.5551234567",
+	}, c)
+}
+
+func TestFormatPhone(t *testing.T) {
+	tests := []struct {
+		phone   string
+		country string
+		want    string
+	}{
+		{"+44 20 7946 0958", "US", "+44.2079460958"},
+		{"0044 (20) 7946-0958", "", "+44.2079460958"},
+		{"020 7946 0958", "GB", "+44.2079460958"},
+		{"+380.441234567", "", "+380.441234567"},
+		{"+7 495 123-45-67", "", "+7.4951234567"},
+		{"+1-555-123-4567 ext 5", "US", ""},
+		{"5551234567", "", ""},
+		{"+999 1234567", "", ""},
+		{"+44 12", "", ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := formatPhone(tt.phone, tt.country)
+		require.Equal(t, tt.want != "", ok, tt.phone)
+		if ok {
+			require.Equal(t, tt.want, got, tt.phone)
+		}
+	}
+}
+
+func TestContactValidate(t *testing.T) {
+	require.NoError(t, validContact.Validate())
+
+	c := &Contact{
+		Name:    strings.Repeat("a", 256),
+		Email:   "John <john@example.com>",
+		Address: " 1 Main St",
+		Zip:     strings.Repeat("1", 17),
+		Country: "XX",
+		Phone:   "+1 555 1234567",
+	}
+	err := c.Validate()
+	require.ErrorIs(t, err, ErrValidation)
+	require.Equal(t, []string{"name", "address", "city", "zip", "email", "country", "phone"}, invalidFields(t, err))
+
+	var nilContact *Contact
+	require.ErrorIs(t, nilContact.Validate(), ErrValidation)
+	require.Len(t, countryCallingCodes, 249)
+}
+
+func TestContactValidateNested(t *testing.T) {
+	opt := newRegisterOptions("test.com")
+	opt.TechContact = &Contact{Name: "John", Email: "john@", Address: "1 Main St", City: "Springfield", Country: "US", Phone: "+1.5551234567"}
+	require.Equal(t, []string{"tech_contact.email"}, invalidFields(t, opt.Validate()))
+
+	whois := &UpdateWhoisInfoOptions{AdminContact: &Contact{}}
+	require.Equal(t, []string{"admin_contact.name", "admin_contact.email", "admin_contact.address", "admin_contact.city", "admin_contact.country", "admin_contact.phone"},
+		invalidFields(t, whois.Validate()))
+}
@@ -0,0 +1,168 @@
+package pananames
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Represents a domain name split into parts by the TLD catalog
+// All parts are lower case ASCII, internationalized labels are converted to A-labels
+type DomainName struct {
+	Subdomain string
+	Name      string
+	TLD       string
+}
+
+// Represents an invalid domain name, it matches ErrValidation and the cause, e.g. ErrTLDNotSupported
+type DomainNameError struct {
+	Name   string
+	Reason string
+	Err    error
+}
+
+// Represents a catalog of TLDs sold by the account, it's safe for concurrent use
+type TLDCatalog struct {
+	mu   sync.RWMutex
+	tlds map[string]*TLD
+}
+
+func (e *DomainNameError) Error() string {
+	return fmt.Sprintf("invalid domain name %q: %s", e.Name, e.Reason)
+}
+
+func (e *DomainNameError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{ErrValidation}
+	}
+	return []error{ErrValidation, e.Err}
+}
+
+// Domain returns the registrable domain name without subdomain
+func (d *DomainName) Domain() string {
+	return d.Name + "." + d.TLD
+}
+
+// String returns the full domain name
+func (d *DomainName) String() string {
+	if d.Subdomain == "" {
+		return d.Domain()
+	}
+	return d.Subdomain + "." + d.Domain()
+}
+
+// Creates a new catalog of the TLDs
+func NewTLDCatalog(tlds []*TLD) *TLDCatalog {
+	cat := &TLDCatalog{}
+	cat.Update(tlds)
+	return cat
+}
+
+// Get TLD catalog of the account from GetTLDs()
+func (c *Client) GetTLDCatalog(ctx context.Context, options ...RequestOptionFunc) (*TLDCatalog, error) {
+	tlds, err := c.GetTLDsCtx(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return NewTLDCatalog(tlds), nil
+}
+
+// Update Replace TLDs of the catalog, e.g. with a fresh list from GetTLDs()
+func (cat *TLDCatalog) Update(tlds []*TLD) {
+	m := make(map[string]*TLD, len(tlds))
+	for _, t := range tlds {
+		if t == nil {
+			continue
+		}
+		if key, err := normalizeName(t.TLD); err == nil && key != "" {
+			m[key] = t
+		}
+	}
+	cat.mu.Lock()
+	defer cat.mu.Unlock()
+	cat.tlds = m
+}
+
+// Lookup returns the TLD info, the TLD may be given in any case, with or without leading dot
+func (cat *TLDCatalog) Lookup(tld string) (*TLD, bool) {
+	key, err := normalizeName(strings.TrimPrefix(tld, "."))
+	if err != nil {
+		return nil, false
+	}
+	cat.mu.RLock()
+	defer cat.mu.RUnlock()
+	t, ok := cat.tlds[key]
+	return t, ok
+}
+
+// Parse Split the domain name into subdomain, name and TLD
+// The longest TLD of the catalog matching the name is used.
+// Returns *DomainNameError matching ErrTLDNotSupported if no TLD of the catalog matches
+func (cat *TLDCatalog) Parse(name string) (*DomainName, error) {
+	ascii, err := normalizeName(name)
+	if err != nil {
+		return nil, &DomainNameError{Name: name, Reason: err.Error()}
+	}
+	if !validDomainName(ascii) {
+		return nil, &DomainNameError{Name: name, Reason: "bad syntax"}
+	}
+
+	labels := strings.Split(ascii, ".")
+	cat.mu.RLock()
+	defer cat.mu.RUnlock()
+	// labels[0] is at least the name, so TLD starts from the second label
+	for i := 1; i < len(labels); i++ {
+		tld := strings.Join(labels[i:], ".")
+		if _, ok := cat.tlds[tld]; ok {
+			return &DomainName{
+				Subdomain: strings.Join(labels[:i-1], "."),
+				Name:      labels[i-1],
+				TLD:       tld,
+			}, nil
+		}
+	}
+	return nil, &DomainNameError{Name: name, Reason: "tld is not supported by the account", Err: ErrTLDNotSupported}
+}
+
+// ParseRegistrable Same as Parse() but the name must not have a subdomain
+func (cat *TLDCatalog) ParseRegistrable(name string) (*DomainName, error) {
+	d, err := cat.Parse(name)
+	if err != nil {
+		return nil, err
+	}
+	if d.Subdomain != "" {
+		return nil, &DomainNameError{Name: name, Reason: fmt.Sprintf("is a subdomain of %s", d.Domain())}
+	}
+	return d, nil
+}
+
+// WithTLDCatalog Set TLD catalog used to check domains of RegisterDomain(), InitTransferIn() and CheckDomainsBulk()
+// Domains on TLDs missing in the catalog and subdomains are rejected before sending
+func WithTLDCatalog(cat *TLDCatalog) Option {
+	return func(c *Client) error {
+		if cat == nil {
+			return fmt.Errorf("%T can't be nil", cat)
+		}
+		c.tldCatalog = cat
+		return nil
+	}
+}
+
+// Check the domain is registrable on TLD of the catalog, if any
+func (c *Client) checkTLD(domain string) error {
+	if c.tldCatalog == nil {
+		return nil
+	}
+	_, err := c.tldCatalog.ParseRegistrable(domain)
+	return err
+}
+
+// Trim spaces and trailing dot, convert to lower case ASCII
+func normalizeName(name string) (string, error) {
+	name = strings.TrimSuffix(strings.TrimSpace(name), ".")
+	if isASCII(name) {
+		return strings.ToLower(name), nil
+	}
+	return ToASCII(name)
+}
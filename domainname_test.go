@@ -0,0 +1,96 @@
+package pananames
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testCatalog = NewTLDCatalog([]*TLD{{TLD: "COM"}, {TLD: "UK"}, {TLD: "CO.UK"}, {TLD: "РФ"}})
+
+func TestTLDCatalogParse(t *testing.T) {
+	tests := []struct {
+		name string
+		want *DomainName
+	}{
+		{"example.com", &DomainName{Name: "example", TLD: "com"}},
+		{"WWW.Shop.Example.CO.UK.", &DomainName{Subdomain: "www.shop", Name: "example", TLD: "co.uk"}},
+		{" example.uk ", &DomainName{Name: "example", TLD: "uk"}},
+		{"пример.рф", &DomainName{Name: "xn--e1afmkfd", TLD: "xn--p1ai"}},
+	}
+	for _, tt := range tests {
+		got, err := testCatalog.Parse(tt.name)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.want, got, tt.name)
+	}
+
+	got, err := testCatalog.Parse("www.shop.example.co.uk")
+	require.NoError(t, err)
+	require.Equal(t, "example.co.uk", got.Domain())
+	require.Equal(t, "www.shop.example.co.uk", got.String())
+
+	_, err = testCatalog.Parse("example.org")
+	require.ErrorIs(t, err, ErrTLDNotSupported)
+	require.ErrorIs(t, err, ErrValidation)
+	require.EqualError(t, err, `invalid domain name "example.org": tld is not supported by the account`)
+
+	for _, name := range []string{"com", "bad_name.com", ""} {
+		_, err = testCatalog.Parse(name)
+		require.ErrorIs(t, err, ErrValidation, name)
+	}
+
+	_, err = testCatalog.ParseRegistrable("www.example.com")
+	require.EqualError(t, err, `invalid domain name "www.example.com": is a subdomain of example.com`)
+
+	tld, ok := testCatalog.Lookup(".Co.Uk")
+	require.True(t, ok)
+	require.Equal(t, "CO.UK", tld.TLD)
+}
+
+func TestGetTLDCatalog(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"tlds", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "tlds.json")
+	})
+
+	cat, err := client.GetTLDCatalog(context.Background())
+	require.NoError(t, err)
+	got, err := cat.Parse("test.xyz")
+	require.NoError(t, err)
+	require.Equal(t, "xyz", got.TLD)
+
+	cat.Update(nil)
+	_, err = cat.Parse("test.xyz")
+	require.ErrorIs(t, err, ErrTLDNotSupported)
+}
+
+func TestWithTLDCatalog(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var calls int32
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writeFixture(t, w, "domain.json")
+	})
+	require.NoError(t, client.parseOptions(WithTLDCatalog(testCatalog)))
+
+	_, err := client.RegisterDomain(newRegisterOptions("test.org"))
+	require.ErrorIs(t, err, ErrTLDNotSupported)
+	_, err = client.RegisterDomain(newRegisterOptions("www.test.com"))
+	require.ErrorIs(t, err, ErrValidation)
+	_, err = client.CheckDomainsBulk(&CheckDomainsBulkOptions{Domains: []string{"test.com", "test.org"}})
+	require.ErrorIs(t, err, ErrTLDNotSupported)
+	_, err = client.InitTransferIn(&InitTransferInOptions{Domain: "test.org", AuthCode: "code"})
+	require.ErrorIs(t, err, ErrTLDNotSupported)
+	require.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	_, err = client.RegisterDomain(newRegisterOptions("test.com"))
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -1,9 +1,12 @@
 package pananames
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -14,11 +17,11 @@ type Domain struct {
 	Premium          bool               `json:"premium"`
 	AutoRenew        bool               `json:"auto_renew"`
 	WhoisPrivacy     bool               `json:"whois_privacy"`
-	LockStatus       string             `json:"lock_status"`
+	LockStatus       LockStatus         `json:"lock_status"`
 	RegistrationDate *PnTime            `json:"registration_date"`
 	ExpirationDate   *PnTime            `json:"expiration_date"`
 	DeletionDate     *PnDate            `json:"deletion_date"`
-	Status           string             `json:"status"`
+	Status           DomainState        `json:"status"`
 	NameServers      *NameServers       `json:"name_servers"`
 	ChildNameServers []*ChildNameServer `json:"child_name_servers"`
 }
@@ -39,11 +42,11 @@ type DomainCheck struct {
 
 // Represents prices info
 type Prices struct {
-	Currency string  `json:"currency"`
-	Register float64 `json:"register"`
-	Renew    float64 `json:"renew"`
-	Transfer float64 `json:"transfer"`
-	Redeem   float64 `json:"redeem"`
+	Currency string `json:"currency"`
+	Register Money  `json:"register"`
+	Renew    Money  `json:"renew"`
+	Transfer Money  `json:"transfer"`
+	Redeem   Money  `json:"redeem"`
 }
 
 // Represents a contact info
@@ -102,9 +105,9 @@ type Redeem Renew
 // Available options for GetDomains()
 type GetDomainsOptions struct {
 	ListOptions
-	DomainLike string `url:"domain_like,omitempty"`
-	Status     string `url:"status,omitempty"`
-	LockStatus string `url:"lock_status,omitempty"`
+	DomainLike string      `url:"domain_like,omitempty"`
+	Status     DomainState `url:"status,omitempty"`
+	LockStatus LockStatus  `url:"lock_status,omitempty"`
 }
 
 // Available options for CheckDomainsBulk()
@@ -122,7 +125,7 @@ type RegisterDomainOptions struct {
 	Domain            string   `json:"domain,omitempty"`
 	Period            int      `json:"period,omitempty"`
 	WhoisPrivacy      bool     `json:"whois_privacy"`
-	PremiumPrice      float64  `json:"premium_price,omitempty"`
+	PremiumPrice      *Money   `json:"premium_price,omitempty"`
 	ClaimsAccepted    bool     `json:"claims_accepted,omitempty"`
 	AddReqAccepted    bool     `json:"add_req_accepted,omitempty"`
 	RegistrantContact *Contact `json:"registrant_contact,omitempty"`
@@ -133,20 +136,88 @@ type RegisterDomainOptions struct {
 
 // Available options for RenewDomain()
 type RenewDomainOptions struct {
-	Period       string  `json:"period,omitempty"`
-	PremiumPrice float64 `json:"premium_price,omitempty"`
+	Period       string `json:"period,omitempty"`
+	PremiumPrice *Money `json:"premium_price,omitempty"`
+}
+
+// Validate GetDomainsOptions filters
+func (opt *GetDomainsOptions) Validate() error {
+	if opt == nil {
+		return nil
+	}
+	var e fieldErrors
+	e.list(opt.ListOptions)
+	return e.err(opt)
+}
+
+// Validate CheckDomainsBulkOptions for domain names
+func (opt *CheckDomainsBulkOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	if len(opt.Domains) == 0 {
+		e.add("domains", "is required")
+	}
+	for i, d := range opt.Domains {
+		e.domain(fmt.Sprintf("domains[%d]", i), d)
+	}
+	return e.err(opt)
+}
+
+// Validate RegisterDomainOptions for domain name, period and required contacts
+func (opt *RegisterDomainOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	e.domain("domain", opt.Domain)
+	e.period("period", opt.Period)
+	e.price("premium_price", opt.PremiumPrice)
+	e.contact("registrant_contact", opt.RegistrantContact, true)
+	e.contact("admin_contact", opt.AdminContact, true)
+	e.contact("tech_contact", opt.TechContact, true)
+	e.contact("billing_contact", opt.BillingContact, true)
+	return e.err(opt)
+}
+
+// Validate RenewDomainOptions for period and premium price
+func (opt *RenewDomainOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	if period, err := strconv.Atoi(opt.Period); err != nil {
+		e.add("period", "%q is not a number of years", opt.Period)
+	} else {
+		e.period("period", period)
+	}
+	e.price("premium_price", opt.PremiumPrice)
+	return e.err(opt)
+}
+
+// Custom Unmarshall for Prices sets the currency of all prices
+func (p *Prices) UnmarshalJSON(data []byte) error {
+	type prices Prices
+	if err := json.Unmarshal(data, (*prices)(p)); err != nil {
+		return err
+	}
+	for _, m := range []*Money{&p.Register, &p.Renew, &p.Transfer, &p.Redeem} {
+		m.Currency = p.Currency
+	}
+	return nil
 }
 
 // Get paged list of domains available in your account
 func (c *Client) GetDomains(opt *GetDomainsOptions, options ...RequestOptionFunc) ([]*Domain, *Pagination, error) {
-	u := "domains"
-	req, err := c.NewRequest(http.MethodGet, u, opt, options)
-	if err != nil {
-		return nil, nil, err
-	}
+	return c.GetDomainsCtx(context.Background(), opt, options...)
+}
 
-	var result []*Domain
-	resp, err := c.Do(req, &result)
+// Same as GetDomains() with the request context
+func (c *Client) GetDomainsCtx(ctx context.Context, opt *GetDomainsOptions, options ...RequestOptionFunc) ([]*Domain, *Pagination, error) {
+	u := "domains"
+	options = append([]RequestOptionFunc{operation("GetDomains", "")}, options...)
+	result, resp, err := call[[]*Domain](c, ctx, http.MethodGet, u, opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -157,206 +228,208 @@ func (c *Client) GetDomains(opt *GetDomainsOptions, options ...RequestOptionFunc
 // Register a domain name
 // The premium price can be fetched via the CheckDomain() method
 func (c *Client) RegisterDomain(opt *RegisterDomainOptions, options ...RequestOptionFunc) (*Domain, error) {
+	return c.RegisterDomainCtx(context.Background(), opt, options...)
+}
+
+// Same as RegisterDomain() with the request context
+func (c *Client) RegisterDomainCtx(ctx context.Context, opt *RegisterDomainOptions, options ...RequestOptionFunc) (*Domain, error) {
 	u := "domains"
-	req, err := c.NewRequest(http.MethodPost, u, opt, options)
-	if err != nil {
-		return nil, err
+	var domain string
+	if opt != nil {
+		domain = opt.Domain
+		if err := c.checkTLD(opt.Domain); err != nil {
+			return nil, err
+		}
+		ascii, err := c.asciiDomain(opt.Domain)
+		if err != nil {
+			return nil, err
+		}
+		if ascii != opt.Domain {
+			converted := *opt
+			converted.Domain = ascii
+			opt = &converted
+		}
+	}
+	var period int
+	if opt != nil {
+		period = opt.Period
 	}
-	result := new(Domain)
-	_, err = c.Do(req, result)
+	release, err := c.guardBalance(ctx, opt, domain, QuoteRegister, period)
 	if err != nil {
 		return nil, err
 	}
-
-	return result, nil
+	options = append([]RequestOptionFunc{operation("RegisterDomain", domain)}, options...)
+	result, err := Call[*Domain](c, ctx, http.MethodPost, u, opt, options...)
+	release(err)
+	return result, err
 }
 
 // Get information about the domain
 func (c *Client) GetDomain(domain string, options ...RequestOptionFunc) (*Domain, error) {
-	u := fmt.Sprintf("domains/%s", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(Domain)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetDomainCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as GetDomain() with the request context
+func (c *Client) GetDomainCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*Domain, error) {
+	u := fmt.Sprintf("domains/%s", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("GetDomain", domain)}, options...)
+	return Call[*Domain](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Delete domain
 func (c *Client) DeleteDomain(domain string, options ...RequestOptionFunc) error {
-	u := fmt.Sprintf("domains/%s", domain)
-	req, err := c.NewRequest(http.MethodDelete, u, nil, options)
-	if err != nil {
-		return err
-	}
-	_, err = c.Do(req, nil)
-	if err != nil {
-		return err
-	}
+	return c.DeleteDomainCtx(context.Background(), domain, options...)
+}
 
+// Same as DeleteDomain() with the request context
+func (c *Client) DeleteDomainCtx(ctx context.Context, domain string, options ...RequestOptionFunc) error {
+	u := fmt.Sprintf("domains/%s", domain)
+	options = append([]RequestOptionFunc{operation("DeleteDomain", domain)}, options...)
+	_, err := Call[struct{}](c, ctx, http.MethodDelete, u, nil, options...)
 	return err
 }
 
 // Check domain availability and pricing
 // This method provides crucial information needed for registration of a domain, as well as domain renewal, transfer and redemption costs
 func (c *Client) CheckDomain(domain string, options ...RequestOptionFunc) (*DomainCheck, error) {
-	u := fmt.Sprintf("domains/%s/check", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(DomainCheck)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.CheckDomainCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as CheckDomain() with the request context
+func (c *Client) CheckDomainCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*DomainCheck, error) {
+	u := fmt.Sprintf("domains/%s/check", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("CheckDomain", domain)}, options...)
+	return Call[*DomainCheck](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Bulk check the domains availability
 // Get information about the domains availability, prices and claim
 func (c *Client) CheckDomainsBulk(opt *CheckDomainsBulkOptions, options ...RequestOptionFunc) ([]*DomainCheck, error) {
+	return c.CheckDomainsBulkCtx(context.Background(), opt, options...)
+}
+
+// Same as CheckDomainsBulk() with the request context
+func (c *Client) CheckDomainsBulkCtx(ctx context.Context, opt *CheckDomainsBulkOptions, options ...RequestOptionFunc) ([]*DomainCheck, error) {
 	u := "domains/bulk_check"
 	opts := &checkDomainsBulkOptions{}
-	if opt != nil && len(opt.Domains) > 0 {
-		opts.Domains = strings.Join(opt.Domains, ",")
-	} else {
+	if opt == nil || len(opt.Domains) == 0 {
 		return nil, fmt.Errorf("%T can't be nil", opt)
 	}
-
-	req, err := c.NewRequest(http.MethodGet, u, opts, options)
-	if err != nil {
+	if err := c.validate(opt); err != nil {
 		return nil, err
 	}
-
-	var result []*DomainCheck
-	_, err = c.Do(req, &result)
-	if err != nil {
-		return nil, err
+	domains := make([]string, len(opt.Domains))
+	for i, d := range opt.Domains {
+		if err := c.checkTLD(d); err != nil {
+			return nil, err
+		}
+		ascii, err := c.asciiDomain(d)
+		if err != nil {
+			return nil, err
+		}
+		domains[i] = ascii
 	}
+	opts.Domains = strings.Join(domains, ",")
 
-	return result, nil
+	options = append([]RequestOptionFunc{operation("CheckDomainsBulk", "")}, options...)
+	return Call[[]*DomainCheck](c, ctx, http.MethodGet, u, opts, options...)
 }
 
 // Get claim information for the domain
 func (c *Client) GetDomainClaim(domain string, options ...RequestOptionFunc) ([]*Claim, error) {
-	u := fmt.Sprintf("domains/%s/claim", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-
-	var result []*Claim
-	_, err = c.Do(req, &result)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetDomainClaimCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as GetDomainClaim() with the request context
+func (c *Client) GetDomainClaimCtx(ctx context.Context, domain string, options ...RequestOptionFunc) ([]*Claim, error) {
+	u := fmt.Sprintf("domains/%s/claim", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("GetDomainClaim", domain)}, options...)
+	return Call[[]*Claim](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Get list of status codes set for the domain
 // EPP Status Code with description: https://www.icann.org/resources/pages/epp-status-codes-2014-06-16-en
 func (c *Client) GetDomainStatusCodes(domain string, options ...RequestOptionFunc) ([]string, error) {
-	u := fmt.Sprintf("domains/%s/status_codes", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := []string{}
-	_, err = c.Do(req, &result)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetDomainStatusCodesCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as GetDomainStatusCodes() with the request context
+func (c *Client) GetDomainStatusCodesCtx(ctx context.Context, domain string, options ...RequestOptionFunc) ([]string, error) {
+	u := fmt.Sprintf("domains/%s/status_codes", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("GetDomainStatusCodes", domain)}, options...)
+	return Call[[]string](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Enable auto renew of the domain
 func (c *Client) EnableDomainAutoRenew(domain string, options ...RequestOptionFunc) (*AutoRenew, error) {
-	u := fmt.Sprintf("domains/%s/auto_renew", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-	result := new(AutoRenew)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.EnableDomainAutoRenewCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as EnableDomainAutoRenew() with the request context
+func (c *Client) EnableDomainAutoRenewCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*AutoRenew, error) {
+	u := fmt.Sprintf("domains/%s/auto_renew", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("EnableDomainAutoRenew", domain)}, options...)
+	return Call[*AutoRenew](c, ctx, http.MethodPut, u, nil, options...)
 }
 
 // Disable auto renew of the domain
 func (c *Client) DisableDomainAutoRenew(domain string, options ...RequestOptionFunc) (*AutoRenew, error) {
-	u := fmt.Sprintf("domains/%s/auto_renew", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodDelete, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-	result := new(AutoRenew)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.DisableDomainAutoRenewCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as DisableDomainAutoRenew() with the request context
+func (c *Client) DisableDomainAutoRenewCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*AutoRenew, error) {
+	u := fmt.Sprintf("domains/%s/auto_renew", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("DisableDomainAutoRenew", domain)}, options...)
+	return Call[*AutoRenew](c, ctx, http.MethodDelete, u, nil, options...)
 }
 
 // Renew tne domain. The domain may be renewed only for a period 1 to 10 years
 // An information about minimum and maximum registration period is available via GetTLDs() method
 func (c *Client) RenewDomain(domain string, opt *RenewDomainOptions, options ...RequestOptionFunc) (*Renew, error) {
+	return c.RenewDomainCtx(context.Background(), domain, opt, options...)
+}
+
+// Same as RenewDomain() with the request context
+func (c *Client) RenewDomainCtx(ctx context.Context, domain string, opt *RenewDomainOptions, options ...RequestOptionFunc) (*Renew, error) {
 	u := fmt.Sprintf("domains/%s/renew", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
-	result := new(Renew)
-	_, err = c.Do(req, result)
+	release, err := c.guardBalance(ctx, opt, domain, QuoteRenew, renewPeriod(opt))
 	if err != nil {
 		return nil, err
 	}
-
-	return result, nil
+	options = append([]RequestOptionFunc{operation("RenewDomain", domain), nonIdempotent()}, options...)
+	result, err := Call[*Renew](c, ctx, http.MethodPut, u, opt, options...)
+	release(err)
+	return result, err
 }
 
 // Restore domain name during Redemption Grace Period
 func (c *Client) RedeemDomain(domain string, options ...RequestOptionFunc) (*Redeem, error) {
+	return c.RedeemDomainCtx(context.Background(), domain, options...)
+}
+
+// Same as RedeemDomain() with the request context
+func (c *Client) RedeemDomainCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*Redeem, error) {
 	u := fmt.Sprintf("domains/%s/redeem", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-	result := new(Redeem)
-	_, err = c.Do(req, result)
+	release, err := c.guardBalance(ctx, nil, domain, QuoteRedeem, 1)
 	if err != nil {
 		return nil, err
 	}
-
-	return result, nil
+	options = append([]RequestOptionFunc{operation("RedeemDomain", domain), nonIdempotent()}, options...)
+	result, err := Call[*Redeem](c, ctx, http.MethodPut, u, nil, options...)
+	release(err)
+	return result, err
 }
 
 // Resend verification email
 func (c *Client) ResendDomainEmail(domain string, options ...RequestOptionFunc) error {
-	u := fmt.Sprintf("domains/%s/resend", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, nil, options)
-	if err != nil {
-		return err
-	}
-	_, err = c.Do(req, nil)
-	if err != nil {
-		return err
-	}
+	return c.ResendDomainEmailCtx(context.Background(), domain, options...)
+}
 
-	return nil
+// Same as ResendDomainEmail() with the request context
+func (c *Client) ResendDomainEmailCtx(ctx context.Context, domain string, options ...RequestOptionFunc) error {
+	u := fmt.Sprintf("domains/%s/resend", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("ResendDomainEmail", domain), nonIdempotent()}, options...)
+	_, err := Call[struct{}](c, ctx, http.MethodPut, u, nil, options...)
+	return err
 }
@@ -8,13 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var wantPrices = &Prices{
-	Currency: "usd",
-	Register: 1.00,
-	Renew:    2.00,
-	Transfer: 3.00,
-	Redeem:   4.00,
-}
+var wantPrices = usdPrices("1", "2", "3", "4")
 var wantDomainInfo = &Domain{
 	Domain:           "test.com",
 	DomainIDN:        "string",
@@ -77,10 +71,10 @@ func TestRegisterDomain(t *testing.T) {
 		WhoisPrivacy:      true,
 		ClaimsAccepted:    false,
 		AddReqAccepted:    false,
-		RegistrantContact: wantContact,
-		TechContact:       wantContact,
-		BillingContact:    wantContact,
-		AdminContact:      wantContact,
+		RegistrantContact: validContact,
+		TechContact:       validContact,
+		BillingContact:    validContact,
+		AdminContact:      validContact,
 	}
 
 	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,106 @@
+package pananames
+
+// Represents a domain status
+// Values not listed below may be returned by the API, they are kept as is
+type DomainState string
+
+// Represents a domain lock status
+type LockStatus string
+
+// Represents a transfer in status
+// Values not listed below may be returned by the API, they are kept as is
+type TransferStatus string
+
+// Represents an email verification status
+type EmailStatus string
+
+// Represents a payment transaction type
+// Values not listed below may be returned by the API, they are kept as is
+type PaymentType string
+
+// Known domain statuses
+const (
+	DomainStateOK         DomainState = "ok"
+	DomainStateSuspended  DomainState = "suspended"
+	DomainStateExpired    DomainState = "expired"
+	DomainStateRedemption DomainState = "redemption"
+)
+
+// Known domain lock statuses
+const (
+	LockStatusLocked   LockStatus = "locked"
+	LockStatusUnlocked LockStatus = "unlocked"
+)
+
+// Known transfer in statuses
+const (
+	TransferStatusWaitingConfirmation TransferStatus = "waiting registrant confirmation"
+	TransferStatusPending             TransferStatus = "pending"
+	TransferStatusCompleted           TransferStatus = "completed"
+	TransferStatusCancelled           TransferStatus = "cancelled"
+	TransferStatusRejected            TransferStatus = "rejected"
+)
+
+// Known email verification statuses
+const (
+	EmailStatusUnverified EmailStatus = "unverified"
+	EmailStatusVerified   EmailStatus = "verified"
+	EmailStatusSuspended  EmailStatus = "suspended"
+)
+
+// Known payment transaction types
+const (
+	PaymentTypeCreate   PaymentType = "create"
+	PaymentTypeRenew    PaymentType = "renew"
+	PaymentTypeTransfer PaymentType = "transfer"
+	PaymentTypeRedeem   PaymentType = "redeem"
+	PaymentTypeDeposit  PaymentType = "deposit"
+	PaymentTypeRefund   PaymentType = "refund"
+)
+
+// Valid reports whether s is a known domain status
+func (s DomainState) Valid() bool {
+	switch s {
+	case DomainStateOK, DomainStateSuspended, DomainStateExpired, DomainStateRedemption:
+		return true
+	}
+	return false
+}
+
+// Valid reports whether s is a known lock status
+func (s LockStatus) Valid() bool {
+	switch s {
+	case LockStatusLocked, LockStatusUnlocked:
+		return true
+	}
+	return false
+}
+
+// Valid reports whether s is a known transfer in status
+func (s TransferStatus) Valid() bool {
+	switch s {
+	case TransferStatusWaitingConfirmation, TransferStatusPending, TransferStatusCompleted,
+		TransferStatusCancelled, TransferStatusRejected:
+		return true
+	}
+	return false
+}
+
+// Valid reports whether s is a known email verification status
+func (s EmailStatus) Valid() bool {
+	switch s {
+	case EmailStatusUnverified, EmailStatusVerified, EmailStatusSuspended:
+		return true
+	}
+	return false
+}
+
+// Valid reports whether t is a known payment transaction type
+func (t PaymentType) Valid() bool {
+	switch t {
+	case PaymentTypeCreate, PaymentTypeRenew, PaymentTypeTransfer, PaymentTypeRedeem,
+		PaymentTypeDeposit, PaymentTypeRefund:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package pananames
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumsValid(t *testing.T) {
+	require.True(t, DomainStateSuspended.Valid())
+	require.False(t, DomainState("suspend").Valid())
+	require.False(t, DomainState("").Valid())
+	require.True(t, LockStatusUnlocked.Valid())
+	require.False(t, LockStatus("Unlocked").Valid())
+	require.True(t, TransferStatusWaitingConfirmation.Valid())
+	require.False(t, TransferStatus("unknown").Valid())
+	require.True(t, EmailStatusUnverified.Valid())
+	require.False(t, EmailStatus("bounced").Valid())
+	require.True(t, PaymentTypeRenew.Valid())
+	require.False(t, PaymentType("renewal").Valid())
+}
+
+func TestEnumsDecodeUnknown(t *testing.T) {
+	var d Domain
+	err := json.Unmarshal([]byte(`{"status":"pending delete","lock_status":"locked"}`), &d)
+	require.NoError(t, err)
+	require.Equal(t, DomainState("pending delete"), d.Status)
+	require.False(t, d.Status.Valid())
+	require.Equal(t, LockStatusLocked, d.LockStatus)
+}
+
+func TestEnumsQuery(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"account/payments", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "renew", r.URL.Query().Get("pay_type"))
+		writeFixture(t, w, "payments.json")
+	})
+
+	got, _, err := client.GetAccountPayments(&GetAccountPaymentsOptions{PayType: PaymentTypeRenew})
+	require.NoError(t, err)
+	require.Equal(t, PaymentTypeCreate, got[0].TxType)
+}
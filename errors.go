@@ -0,0 +1,172 @@
+package pananames
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched by errors.Is against errors returned by api client
+var (
+	ErrNotFound            = errors.New("pananames: not found")
+	ErrUnauthorized        = errors.New("pananames: unauthorized")
+	ErrInsufficientBalance = errors.New("pananames: insufficient balance")
+	ErrDomainNotAvailable  = errors.New("pananames: domain not available")
+	ErrValidation          = errors.New("pananames: validation failed")
+	ErrRateLimited         = errors.New("pananames: rate limited")
+	ErrTLDNotSupported     = errors.New("pananames: tld not supported")
+	ErrPremiumPriceTooHigh = errors.New("pananames: premium price too high")
+)
+
+// Message fragments of API client errors with codes outside of HTTP range
+var errorMessageKinds = []struct {
+	fragment string
+	kind     error
+}{
+	{"insufficient funds", ErrInsufficientBalance},
+	{"insufficient balance", ErrInsufficientBalance},
+	{"not enough money", ErrInsufficientBalance},
+	{"not available for registration", ErrDomainNotAvailable},
+	{"already registered", ErrDomainNotAvailable},
+}
+
+// Operations where a conflict means the domain is registered or being transferred already
+// Conflicts of other operations, e.g. a duplicate name server record, are not matched
+var conflictOperations = map[string]bool{
+	"RegisterDomain":   true,
+	"CheckDomain":      true,
+	"CheckDomainsBulk": true,
+	"InitTransferIn":   true,
+}
+
+// Represents an error response of the API which body can't be parsed
+type StatusError struct {
+	Response *http.Response
+	Body     []byte
+}
+
+// Represents an error of decoding successful API response
+type DecodeError struct {
+	StatusCode int
+	Reason     string
+	Err        error
+}
+
+// Represents a failure to get any response for the request
+type transportError struct {
+	req *http.Request
+	err error
+}
+
+func (e *APIError) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("pananames: error code %d: %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("pananames: error code %d: %s: %s", e.Code, e.Message, e.Description)
+}
+
+// Unwrap returns sentinel error matching the API error code, if any
+// API specific codes of 4xx responses are matched by the message
+func (e *APIError) Unwrap() error {
+	// API error codes in HTTP range have the same meaning as HTTP status codes
+	if kind := statusKind(e.Code, e.operation); kind != nil {
+		return kind
+	}
+	if e.status < 400 || e.status >= 500 {
+		return nil
+	}
+	msg := strings.ToLower(e.Message + " " + e.Description)
+	for _, k := range errorMessageKinds {
+		if strings.Contains(msg, k.fragment) {
+			return k.kind
+		}
+	}
+	return nil
+}
+
+// Unwrap returns sentinel error matching HTTP status and every API error
+// Allows errors.Is(err, ErrNotFound) and errors.As(err, &apiErr) with *APIError
+func (e *ErrorResponse) Unwrap() []error {
+	var errs []error
+	if e.Response != nil {
+		if kind := statusKind(e.Response.StatusCode, responseOperation(e.Response)); kind != nil {
+			errs = append(errs, kind)
+		}
+	}
+	for i := range e.Errors {
+		errs = append(errs, &e.Errors[i])
+	}
+	return errs
+}
+
+// Returns HTTP status code of the error response
+func (e *ErrorResponse) StatusCode() int {
+	if e.Response == nil {
+		return 0
+	}
+	return e.Response.StatusCode
+}
+
+// Map HTTP status code of the API operation to sentinel error
+func statusKind(code int, operation string) error {
+	switch code {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusPaymentRequired:
+		return ErrInsufficientBalance
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		if conflictOperations[operation] {
+			return ErrDomainNotAvailable
+		}
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	}
+	return nil
+}
+
+func (e *StatusError) Error() string {
+	if len(e.Body) == 0 {
+		return fmt.Sprintf("status: %d, empty response", e.Response.StatusCode)
+	}
+	return fmt.Sprintf("status: %d, can't parse error, unknown format, raw data: %s", e.Response.StatusCode, e.Body)
+}
+
+// Unwrap returns sentinel error matching HTTP status, if any
+func (e *StatusError) Unwrap() error {
+	return statusKind(e.Response.StatusCode, responseOperation(e.Response))
+}
+
+// Get API operation name of the response request, empty if unknown
+func responseOperation(r *http.Response) string {
+	if r == nil || r.Request == nil {
+		return ""
+	}
+	if cfg := getRequestConfig(r.Request); cfg != nil {
+		return cfg.operation
+	}
+	return ""
+}
+
+func (e *DecodeError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("status: %d, %s", e.StatusCode, e.Reason)
+	}
+	return fmt.Sprintf("status: %d, %s: %v", e.StatusCode, e.Reason, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func (e *transportError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transportError) Unwrap() error {
+	return e.err
+}
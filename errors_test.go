@@ -0,0 +1,96 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponseIs(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   error
+	}{
+		{http.StatusNotFound, `{"errors":[{"code":404,"message":"Domain not found"}]}`, ErrNotFound},
+		{http.StatusUnauthorized, `{"errors":[{"code":401,"message":"Wrong signature"}]}`, ErrUnauthorized},
+		{http.StatusForbidden, `{"errors":[]}`, ErrUnauthorized},
+		{http.StatusTooManyRequests, `{"errors":[{"code":429,"message":"Too many requests"}]}`, ErrRateLimited},
+		{http.StatusUnprocessableEntity, `{"errors":[{"code":422,"message":"Invalid period"}]}`, ErrValidation},
+		{http.StatusUnprocessableEntity, `{"errors":[{"code":4000,"message":"Insufficient funds"}]}`, ErrInsufficientBalance},
+		{http.StatusBadRequest, `{"errors":[{"code":4001,"message":"Domain is not available for registration"}]}`, ErrDomainNotAvailable},
+		// messages of server errors are not classified
+		{http.StatusServiceUnavailable, `{"errors":[{"code":503,"message":"Service not available"}]}`, nil},
+		{http.StatusInternalServerError, `{"errors":[{"code":5000,"message":"Insufficient funds in the pool"}]}`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d %v", tt.status, tt.want), func(t *testing.T) {
+			mux, server, client := setup(t)
+			defer teardown(server)
+
+			mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			_, err := client.GetDomain("test.com")
+			if tt.want == nil {
+				require.NotErrorIs(t, err, ErrDomainNotAvailable)
+				require.NotErrorIs(t, err, ErrInsufficientBalance)
+			} else {
+				require.ErrorIs(t, err, tt.want)
+			}
+
+			var errResp *ErrorResponse
+			require.True(t, errors.As(err, &errResp))
+			require.Equal(t, tt.status, errResp.StatusCode())
+		})
+	}
+}
+
+func TestErrorResponseConflict(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	conflict := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"errors":[{"code":409,"message":"Conflict"}]}`))
+	}
+	mux.HandleFunc(apiVerPath+"domains", conflict)
+	mux.HandleFunc(apiVerPath+"domains/test.com/child_name_servers", conflict)
+	mux.HandleFunc(apiVerPath+"domains/test.com/records", conflict)
+
+	_, err := client.RegisterDomain(newRegisterOptions("test.com"))
+	require.ErrorIs(t, err, ErrDomainNotAvailable)
+
+	// conflicts of other operations don't mean the domain is taken
+	_, err = client.AddChildNameServer("test.com", &ChildNameServerOptions{Hostname: "ns1.test.com", IPv4: "192.0.2.1"})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrDomainNotAvailable)
+	_, err = Call[struct{}](client, context.Background(), http.MethodPost, "domains/test.com/records", nil)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrDomainNotAvailable)
+}
+
+func TestErrorResponseAsAPIError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"domains/test.com/check", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errors":[{"code":4002,"message":"Invalid domain","description":"Domain has invalid characters"}]}`))
+	})
+
+	_, err := client.CheckDomain("test.com")
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, 4002, apiErr.Code)
+	require.Equal(t, "pananames: error code 4002: Invalid domain: Domain has invalid characters", apiErr.Error())
+	require.ErrorIs(t, err, ErrValidation)
+	require.NotErrorIs(t, err, ErrNotFound)
+}
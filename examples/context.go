@@ -10,19 +10,26 @@ import (
 )
 
 // Redeem domain with context
-// Context can be passed to any func
+// Every method has a Ctx variant taking context as the first argument
 func RedeemWithContext() {
 	pnClient, err := pananames.NewClient("token")
 	if err != nil {
 		log.Fatalf("Failed to create client: %v", err)
 	}
 
-	ct, cancel := context.WithTimeout(context.Background(), time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	timeoutContext := pananames.WithContext(ct)
-	redeemInfo, err := pnClient.RedeemDomain("test.com", timeoutContext)
+	redeemInfo, err := pnClient.RedeemDomainCtx(ctx, "test.com")
 	if err != nil {
 		log.Fatalf("Failed to redeem domain: %v", err)
 	}
 	fmt.Println(redeemInfo.NewExpirationDate)
+
+	// Context can also be passed to any func as request option
+	timeoutContext := pananames.WithContext(ctx)
+	domainInfo, err := pnClient.GetDomain("test.com", timeoutContext)
+	if err != nil {
+		log.Fatalf("Failed to get domain: %v", err)
+	}
+	fmt.Println(domainInfo.ExpirationDate)
 }
@@ -46,7 +46,7 @@ func GetDomain() {
 	}
 
 	// with filter Status
-	listOptions := &pananames.GetDomainsOptions{Status: "suspended"}
+	listOptions := &pananames.GetDomainsOptions{Status: pananames.DomainStateSuspended}
 	domainsInfo, _, err = pnClient.GetDomains(listOptions)
 	for _, d := range domainsInfo {
 		fmt.Println(d.Domain)
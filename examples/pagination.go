@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,17 +15,20 @@ func Pagination() {
 		log.Fatalf("Failed to create client: %v", err)
 	}
 
-	listOptions := &pananames.GetDomainsOptions{ListOptions: pananames.ListOptions{Limit: 30, Page: 1}}
-	for {
-		domains, page, err := pnClient.GetDomains(listOptions)
-		if err != nil {
-			fmt.Println(err)
-		}
-		for _, d := range domains {
-			fmt.Println(d.Domain, d.Status)
-		}
-		if listOptions.Page = page.NextPage(); listOptions.Page == 0 {
-			break
-		}
+	listOptions := &pananames.GetDomainsOptions{ListOptions: pananames.ListOptions{Limit: 30}}
+	it := pnClient.PaginateDomains(context.Background(), listOptions)
+	for it.Next() {
+		d := it.Value()
+		fmt.Println(d.Domain, d.Status)
 	}
+	if err := it.Err(); err != nil {
+		log.Fatalf("Failed to get domains: %v", err)
+	}
+
+	// or collect all emails at once
+	emails, err := pnClient.PaginateEmails(context.Background(), nil).All()
+	if err != nil {
+		log.Fatalf("Failed to get emails: %v", err)
+	}
+	fmt.Println(len(emails))
 }
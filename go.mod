@@ -1,15 +1,16 @@
 module github.com/pananames/go-api-client
 
-go 1.17
+go 1.21
 
 require (
 	github.com/google/go-querystring v1.1.0
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/net v0.25.0
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v2 v2.4.0 // indirect
+	golang.org/x/text v0.15.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,226 @@
+package pananames
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Represents a guard refusing spending operations which would bring the account balance below the floor
+// Costs of operations in progress are reserved, so concurrent operations can't overspend together.
+// It's safe for concurrent use and may be shared by clients of the same account
+type BalanceGuard struct {
+	floor Money
+
+	mu      sync.Mutex
+	pending map[*reservation]struct{}
+	settled []*reservation
+	// Start times of balance requests in progress, settled costs are kept until they are finished
+	checks map[*time.Time]struct{}
+}
+
+// Represents the reserved cost of a spending operation
+type reservation struct {
+	cost Money
+	// Time the operation finished, zero while it's in progress
+	done time.Time
+}
+
+// Represents a spending operation refused by the balance guard, it matches ErrInsufficientBalance
+type BalanceError struct {
+	Domain    string
+	Operation QuoteOperation
+	// Estimated cost of the operation
+	Cost    Money
+	Balance Money
+	// Costs of other operations in progress or not reflected in the balance yet
+	Reserved Money
+	Floor    Money
+}
+
+func (e *BalanceError) Error() string {
+	return fmt.Sprintf("balance guard: %s of %s costs %s, balance %s with %s reserved would go below %s",
+		e.Operation, e.Domain, e.Cost, e.Balance, e.Reserved, e.Floor)
+}
+
+func (e *BalanceError) Unwrap() error {
+	return ErrInsufficientBalance
+}
+
+// Creates a new balance guard keeping the balance at or above the floor
+func NewBalanceGuard(floor Money) *BalanceGuard {
+	return &BalanceGuard{
+		floor:   floor,
+		pending: make(map[*reservation]struct{}),
+		checks:  make(map[*time.Time]struct{}),
+	}
+}
+
+// WithBalanceGuard Set balance guard of RegisterDomain(), RenewDomain(), RedeemDomain() and InitTransferIn()
+// The cost is estimated with CheckDomain() and the TLD catalog set by WithTLDCatalog(), if any
+func WithBalanceGuard(g *BalanceGuard) Option {
+	return func(c *Client) error {
+		c.balanceGuard = g
+		return nil
+	}
+}
+
+// Reserved returns the sum of costs reserved by the guard
+func (g *BalanceGuard) Reserved() Money {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	reserved, _ := g.reserved(time.Time{})
+	return reserved
+}
+
+// Reserve the cost of the operation if the balance is enough
+// The balance is requested without holding the lock, the reservation is made against that snapshot
+func (g *BalanceGuard) reserve(ctx context.Context, c *Client, domain string, op QuoteOperation, cost Money) (*reservation, error) {
+	// Operations finished before the balance request are reflected in the balance
+	g.mu.Lock()
+	start := time.Now()
+	g.checks[&start] = struct{}{}
+	g.mu.Unlock()
+
+	balance, err := c.GetAccountBalanceCtx(ctx)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.checks, &start)
+	g.prune()
+	if err != nil {
+		return nil, err
+	}
+	reserved, err := g.reserved(start)
+	if err != nil {
+		return nil, err
+	}
+
+	left, err := balance.Balance.Sub(reserved)
+	if err == nil {
+		left, err = left.Sub(cost)
+	}
+	var cmp int
+	if err == nil {
+		cmp, err = left.Cmp(g.floor)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cmp < 0 {
+		return nil, &BalanceError{
+			Domain:    domain,
+			Operation: op,
+			Cost:      cost,
+			Balance:   balance.Balance,
+			Reserved:  reserved,
+			Floor:     g.floor,
+		}
+	}
+
+	r := &reservation{cost: cost}
+	g.pending[r] = struct{}{}
+	return r, nil
+}
+
+// Release the reservation when the operation is finished
+// The cost is kept until the next balance request unless the API definitely rejected the operation,
+// e.g. after success, undecodable response, timeout or server error the account may be charged
+func (g *BalanceGuard) release(r *reservation, err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.pending, r)
+	if err == nil || !rejected(err) {
+		r.done = time.Now()
+		g.settled = append(g.settled, r)
+	}
+}
+
+// Sum of pending costs and costs of operations finished after the time
+func (g *BalanceGuard) reserved(since time.Time) (Money, error) {
+	var sum Money
+	var err error
+	for _, r := range g.settled {
+		if r.done.After(since) {
+			if sum, err = sum.Add(r.cost); err != nil {
+				return Money{}, err
+			}
+		}
+	}
+	for r := range g.pending {
+		if sum, err = sum.Add(r.cost); err != nil {
+			return Money{}, err
+		}
+	}
+	return sum, nil
+}
+
+// Drop settled operations reflected in the balance of every request in progress and made later
+func (g *BalanceGuard) prune() {
+	oldest := time.Now()
+	for start := range g.checks {
+		if start.Before(oldest) {
+			oldest = *start
+		}
+	}
+	kept := g.settled[:0]
+	for _, r := range g.settled {
+		if r.done.After(oldest) {
+			kept = append(kept, r)
+		}
+	}
+	g.settled = kept
+}
+
+// Reserve the cost of the operation if the balance guard is set
+// The returned func must be called with the operation error
+func (c *Client) guardBalance(ctx context.Context, opt interface{}, domain string, op QuoteOperation, period int) (func(error), error) {
+	if c.balanceGuard == nil {
+		return func(error) {}, nil
+	}
+	if err := c.validate(opt); err != nil {
+		return nil, err
+	}
+	cost, err := c.estimateCost(ctx, domain, op, period)
+	if err != nil {
+		return nil, err
+	}
+	r, err := c.balanceGuard.reserve(ctx, c, domain, op, cost)
+	if err != nil {
+		return nil, err
+	}
+	return func(err error) { c.balanceGuard.release(r, err) }, nil
+}
+
+// Estimate the cost of the operation with current prices
+func (c *Client) estimateCost(ctx context.Context, domain string, op QuoteOperation, period int) (Money, error) {
+	check, err := c.CheckDomainCtx(ctx, domain)
+	if err != nil {
+		return Money{}, err
+	}
+	var tld *TLD
+	if c.tldCatalog != nil {
+		if name, err := c.tldCatalog.Parse(domain); err == nil {
+			tld, _ = c.tldCatalog.Lookup(name.TLD)
+		}
+	}
+	item, err := QuoteDomain(check, tld, op, period, time.Now())
+	if err != nil {
+		return Money{}, err
+	}
+	return item.Total, nil
+}
+
+// Number of years of the renew options, 1 if not set
+func renewPeriod(opt *RenewDomainOptions) int {
+	if opt == nil {
+		return 1
+	}
+	period, err := strconv.Atoi(opt.Period)
+	if err != nil {
+		return 1
+	}
+	return period
+}
@@ -0,0 +1,194 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// setup a test http server charging 20 per domain operation from the balance
+func setupGuard(t *testing.T, balance string, options ...Option) (*http.ServeMux, *Client, *Money, *sync.Mutex, func()) {
+	mux, server, client := setup(t)
+	for _, opt := range options {
+		require.NoError(t, opt(client))
+	}
+	var mu sync.Mutex
+	current := MustParseMoney(balance, "")
+	price := MustParseMoney("20", "")
+
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintf(w, `{"data":{"balance":%s}}`, current.Amount())
+	})
+	mux.HandleFunc(apiVerPath+"domains/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"domain":"test.com","available":true,"prices":{"currency":"usd","register":20,"renew":20,"transfer":20,"redeem":20}}}`)
+	})
+	charge := func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		current, _ = current.Sub(price)
+		fmt.Fprint(w, `{"data":{"domain":"test.com"}}`)
+	}
+	mux.HandleFunc(apiVerPath+"domains", charge)
+	mux.HandleFunc(apiVerPath+"domains/test.com/renew", charge)
+	mux.HandleFunc(apiVerPath+"domains/test.com/redeem", charge)
+	mux.HandleFunc(apiVerPath+"transfers_in", charge)
+	return mux, client, &current, &mu, func() { teardown(server) }
+}
+
+func TestBalanceGuard(t *testing.T) {
+	guard := NewBalanceGuard(MustParseMoney("10", ""))
+	_, client, _, _, closeServer := setupGuard(t, "75", WithBalanceGuard(guard))
+	defer closeServer()
+
+	_, err := client.RegisterDomain(newRegisterOptions("test.com"))
+	require.NoError(t, err)
+	_, err = client.RenewDomain("test.com", &RenewDomainOptions{Period: "1"})
+	require.NoError(t, err)
+	_, err = client.RedeemDomain("test.com")
+	require.NoError(t, err)
+
+	// 15 left, floor 10
+	_, err = client.InitTransferIn(&InitTransferInOptions{Domain: "test.com", AuthCode: "123"})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+	var balanceErr *BalanceError
+	require.True(t, errors.As(err, &balanceErr))
+	require.Equal(t, &BalanceError{
+		Domain:    "test.com",
+		Operation: QuoteTransfer,
+		Cost:      MustParseMoney("20", "usd"),
+		Balance:   MustParseMoney("15", ""),
+		Floor:     MustParseMoney("10", ""),
+	}, balanceErr)
+	require.EqualError(t, err, "balance guard: transfer of test.com costs 20 usd, balance 15 with 0 reserved would go below 10")
+	require.True(t, guard.Reserved().IsZero())
+
+	// invalid options are refused before checking the balance
+	_, err = client.RenewDomain("test.com", &RenewDomainOptions{Period: "20"})
+	require.ErrorIs(t, err, ErrValidation)
+}
+
+func TestBalanceGuardConcurrent(t *testing.T) {
+	guard := NewBalanceGuard(MustParseMoney("10", ""))
+	_, client, balance, mu, closeServer := setupGuard(t, "100", WithBalanceGuard(guard))
+	defer closeServer()
+
+	var wg sync.WaitGroup
+	var succeeded, refused int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.RenewDomainCtx(context.Background(), "test.com", &RenewDomainOptions{Period: "1"})
+			if errors.Is(err, ErrInsufficientBalance) {
+				atomic.AddInt32(&refused, 1)
+			} else if err == nil {
+				atomic.AddInt32(&succeeded, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(4), succeeded)
+	require.Equal(t, int32(6), refused)
+	mu.Lock()
+	require.Equal(t, MustParseMoney("20", ""), *balance)
+	mu.Unlock()
+}
+
+func TestBalanceGuardSlowBalance(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	guard := NewBalanceGuard(MustParseMoney("10", ""))
+	require.NoError(t, client.parseOptions(WithBalanceGuard(guard)))
+
+	var calls int32
+	entered, unblock := make(chan struct{}), make(chan struct{})
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(entered)
+			<-unblock
+		}
+		fmt.Fprint(w, `{"data":{"balance":100}}`)
+	})
+	mux.HandleFunc(apiVerPath+"domains/test.com/check", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"domain":"test.com","available":false,"prices":{"currency":"usd","renew":20}}}`)
+	})
+	mux.HandleFunc(apiVerPath+"domains/test.com/renew", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"domain":"test.com"}}`)
+	})
+	defer close(unblock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	slow := make(chan error)
+	go func() {
+		_, err := client.RenewDomainCtx(ctx, "test.com", &RenewDomainOptions{Period: "1"})
+		slow <- err
+	}()
+	<-entered
+
+	// a slow balance request doesn't block other operations
+	_, err := client.RenewDomain("test.com", &RenewDomainOptions{Period: "1"})
+	require.NoError(t, err)
+	// the cost may be missing from the slow balance, it's kept until the request is finished
+	require.Equal(t, MustParseMoney("20", "usd"), guard.Reserved())
+
+	cancel()
+	require.ErrorIs(t, <-slow, context.Canceled)
+	require.True(t, guard.Reserved().IsZero())
+}
+
+func TestBalanceGuardRelease(t *testing.T) {
+	guard := NewBalanceGuard(Money{})
+	mux, client, _, _, closeServer := setupGuard(t, "30", WithBalanceGuard(guard))
+	defer closeServer()
+
+	status := http.StatusBadRequest
+	mux.HandleFunc(apiVerPath+"domains/fail.com/renew", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{"errors":[{"code":400,"message":"Bad request"}]}`)
+	})
+	mux.HandleFunc(apiVerPath+"domains/undecodable.com/renew", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"meta":{}}`)
+	})
+
+	// definite failure releases the reservation
+	_, err := client.RenewDomain("fail.com", &RenewDomainOptions{Period: "1"})
+	require.Error(t, err)
+	require.True(t, guard.Reserved().IsZero())
+
+	status = http.StatusServiceUnavailable
+	_, err = client.RenewDomain("fail.com", &RenewDomainOptions{Period: "1"})
+	require.Error(t, err)
+	require.True(t, guard.Reserved().IsZero())
+
+	// the account may be charged on ambiguous failure, the cost is kept until the next balance check
+	status = http.StatusGatewayTimeout
+	_, err = client.RenewDomain("fail.com", &RenewDomainOptions{Period: "1"})
+	require.Error(t, err)
+	require.Equal(t, MustParseMoney("20", "usd"), guard.Reserved())
+
+	// successful response which can't be decoded means the account is charged
+	_, err = client.RenewDomain("undecodable.com", &RenewDomainOptions{Period: "1"})
+	var decodeErr *DecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	require.Equal(t, MustParseMoney("20", "usd"), guard.Reserved())
+
+	_, err = client.RenewDomain("test.com", &RenewDomainOptions{Period: "1"})
+	require.NoError(t, err)
+	require.Equal(t, MustParseMoney("20", "usd"), guard.Reserved())
+
+	// balance is 10 now, earlier operations are reflected in it
+	_, err = client.RenewDomain("test.com", &RenewDomainOptions{Period: "1"})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+	require.True(t, guard.Reserved().IsZero())
+}
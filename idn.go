@@ -0,0 +1,101 @@
+package pananames
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// IDNA2008 profile used for domain name conversion
+var idnaProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.Transitional(false),
+)
+
+// ToASCII Convert the domain name to the ASCII form with A-labels (punycode)
+// The name is mapped to lower case, ASCII names are returned as is
+func ToASCII(domain string) (string, error) {
+	ascii, err := idnaProfile.ToASCII(domain)
+	if err != nil {
+		return "", fmt.Errorf("idn: %q: %w", domain, err)
+	}
+	return ascii, nil
+}
+
+// ToUnicode Convert the domain name with A-labels (punycode) to the Unicode form
+func ToUnicode(domain string) (string, error) {
+	unicode, err := idnaProfile.ToUnicode(domain)
+	if err != nil {
+		return "", fmt.Errorf("idn: %q: %w", domain, err)
+	}
+	return unicode, nil
+}
+
+// WithIDN Set whether Unicode domain names are accepted and converted to A-labels before sending
+// It applies to domains in request paths, CheckDomainsBulk(), RegisterDomain() and InitTransferIn()
+func WithIDN(enabled bool) Option {
+	return func(c *Client) error {
+		c.idn = enabled
+		return nil
+	}
+}
+
+// UnicodeDomain returns Unicode form of the domain name
+// DomainIDN is used if the API provided it
+func (d *Domain) UnicodeDomain() string {
+	return unicodeDomain(d.Domain, d.DomainIDN)
+}
+
+// UnicodeDomain returns Unicode form of the domain name
+// DomainIDN is used if the API provided it
+func (d *DomainCheck) UnicodeDomain() string {
+	return unicodeDomain(d.Domain, d.DomainIDN)
+}
+
+func unicodeDomain(domain, idn string) string {
+	if idn != "" {
+		return idn
+	}
+	if unicode, err := ToUnicode(domain); err == nil {
+		return unicode
+	}
+	return domain
+}
+
+// Convert the domain name to A-labels if IDN is enabled and the name isn't ASCII
+func (c *Client) asciiDomain(domain string) (string, error) {
+	if !c.idn || isASCII(domain) {
+		return domain, nil
+	}
+	return ToASCII(domain)
+}
+
+// Convert Unicode domain names in the escaped request path to A-labels
+func (c *Client) asciiPath(path string) (string, error) {
+	if !c.idn {
+		return path, nil
+	}
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		name, err := url.PathUnescape(s)
+		if err != nil || isASCII(name) {
+			continue
+		}
+		if segments[i], err = ToASCII(name); err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(segments, "/"), nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package pananames
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIDNConversion(t *testing.T) {
+	got, err := ToASCII("Пример.рф")
+	require.NoError(t, err)
+	require.Equal(t, "xn--e1afmkfd.xn--p1ai", got)
+
+	got, err = ToASCII("test.com")
+	require.NoError(t, err)
+	require.Equal(t, "test.com", got)
+
+	got, err = ToUnicode("xn--e1afmkfd.xn--p1ai")
+	require.NoError(t, err)
+	require.Equal(t, "пример.рф", got)
+
+	_, err = ToASCII("bad_‍.com")
+	require.Error(t, err)
+}
+
+func TestUnicodeDomain(t *testing.T) {
+	require.Equal(t, "пример.рф", (&Domain{Domain: "xn--e1afmkfd.xn--p1ai"}).UnicodeDomain())
+	require.Equal(t, "idn.рф", (&Domain{Domain: "xn--e1afmkfd.xn--p1ai", DomainIDN: "idn.рф"}).UnicodeDomain())
+	require.Equal(t, "test.com", (&DomainCheck{Domain: "test.com"}).UnicodeDomain())
+}
+
+func TestWithIDN(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"domains/xn--e1afmkfd.xn--p1ai", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"domain":"xn--e1afmkfd.xn--p1ai","domain_idn":"пример.рф"}}`))
+	})
+	mux.HandleFunc(apiVerPath+"domains/bulk_check", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "xn--e1afmkfd.xn--p1ai,test.com", r.URL.Query().Get("domains"))
+		w.Write([]byte(`{"data":[]}`))
+	})
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		require.Contains(t, getBody(t, r), `"domain":"xn--e1afmkfd.xn--p1ai"`)
+		writeFixture(t, w, "domain.json")
+	})
+
+	// disabled by default
+	_, err := client.GetDomain("пример.рф")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, client.parseOptions(WithIDN(true)))
+	got, err := client.GetDomain("Пример.рф")
+	require.NoError(t, err)
+	require.Equal(t, "xn--e1afmkfd.xn--p1ai", got.Domain)
+	require.Equal(t, "пример.рф", got.UnicodeDomain())
+
+	_, err = client.CheckDomainsBulk(&CheckDomainsBulkOptions{Domains: []string{"пример.рф", "test.com"}})
+	require.NoError(t, err)
+
+	opt := newRegisterOptions("пример.рф")
+	_, err = client.RegisterDomain(opt)
+	require.NoError(t, err)
+	// options passed by caller are not modified
+	require.Equal(t, "пример.рф", opt.Domain)
+}
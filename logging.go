@@ -0,0 +1,159 @@
+package pananames
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// Header and body fields never written to the log as is
+var redactedHeaders = []string{"SIGNATURE", "Authorization"}
+var redactedFields = map[string]bool{"auth_code": true, "signature": true, "token": true}
+
+// Represents logging settings of api client
+type LogConfig struct {
+	// Level for successful requests, slog.LevelDebug if nil
+	Level slog.Leveler
+	// Level for failed requests, slog.LevelError if nil
+	ErrorLevel slog.Leveler
+	// Log request and response headers
+	LogHeaders bool
+	// Log request and response bodies
+	LogBody bool
+}
+
+// WithLogger Log every request of api client with the logger
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithLogConfig Set logging settings of api client, used along with WithLogger
+func WithLogConfig(cfg LogConfig) Option {
+	return func(c *Client) error {
+		c.logConfig = cfg
+		return nil
+	}
+}
+
+// Build middleware writing request summary to the client logger
+func (c *Client) loggingMiddleware(next Doer) Doer {
+	logger, cfg := c.logger, c.logConfig
+	level, errorLevel := slog.Leveler(slog.LevelDebug), slog.Leveler(slog.LevelError)
+	if cfg.Level != nil {
+		level = cfg.Level
+	}
+	if cfg.ErrorLevel != nil {
+		errorLevel = cfg.ErrorLevel
+	}
+
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		attrs := []slog.Attr{
+			slog.String("method", req.Method),
+			slog.String("path", req.URL.Path),
+		}
+		if req.URL.RawQuery != "" {
+			attrs = append(attrs, slog.String("query", req.URL.RawQuery))
+		}
+		if cfg.LogHeaders {
+			attrs = append(attrs, slog.Any("request_headers", redactHeaders(req.Header)))
+		}
+		if cfg.LogBody && req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				data, _ := io.ReadAll(body)
+				body.Close()
+				if len(data) > 0 {
+					attrs = append(attrs, slog.String("request_body", redactBody(data)))
+				}
+			}
+		}
+
+		start := time.Now()
+		resp, err := next.Do(req)
+		attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+		if resp != nil {
+			attrs = append(attrs, slog.Int("status", resp.StatusCode))
+			if cfg.LogHeaders {
+				attrs = append(attrs, slog.Any("response_headers", redactHeaders(resp.Header)))
+			}
+			if cfg.LogBody && err == nil && resp.Body != nil {
+				data, readErr := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				resp.Body = io.NopCloser(bytes.NewReader(data))
+				if readErr == nil {
+					attrs = append(attrs, slog.String("response_body", redactBody(data)))
+				}
+			}
+		}
+
+		lvl, msg := level.Level(), "pananames request"
+		if err != nil {
+			lvl, msg = errorLevel.Level(), "pananames request failed"
+			var errResp *ErrorResponse
+			if errors.As(err, &errResp) {
+				codes := make([]int, 0, len(errResp.Errors))
+				for _, e := range errResp.Errors {
+					codes = append(codes, e.Code)
+				}
+				attrs = append(attrs, slog.Any("error_codes", codes))
+			}
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+		logger.LogAttrs(req.Context(), lvl, msg, attrs...)
+
+		return resp, err
+	})
+}
+
+// Copy headers with secret values replaced
+func redactHeaders(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range redactedHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, redacted)
+		}
+	}
+	return h
+}
+
+// Replace secret fields in JSON body, non JSON body is returned as is
+func redactBody(data []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return strings.TrimSpace(string(data))
+	}
+	redactValue(v)
+	out, err := json.Marshal(v)
+	if err != nil {
+		return redacted
+	}
+	return string(out)
+}
+
+// Walk decoded JSON value and replace secret fields
+func redactValue(v interface{}) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if redactedFields[strings.ToLower(k)] {
+				val[k] = redacted
+				continue
+			}
+			redactValue(item)
+		}
+	case []interface{}:
+		for _, item := range val {
+			redactValue(item)
+		}
+	}
+}
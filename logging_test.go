@@ -0,0 +1,75 @@
+package pananames
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// returns client logging as JSON to the buffer
+func setupLogger(t *testing.T, client *Client, cfg LogConfig) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	require.NoError(t, client.parseOptions(WithLogger(logger), WithLogConfig(cfg)))
+	return buf
+}
+
+// decodes the single log record from buffer
+func decodeLogRecord(t *testing.T, buf *bytes.Buffer) map[string]interface{} {
+	record := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	return record
+}
+
+func TestLoggerSuccess(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	buf := setupLogger(t, client, LogConfig{LogHeaders: true, LogBody: true})
+
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "balance.json")
+	})
+
+	got, err := client.GetAccountBalance()
+	require.NoError(t, err)
+	require.Equal(t, &Balance{MustParseMoney("12.34", "")}, got)
+
+	record := decodeLogRecord(t, buf)
+	require.Equal(t, "DEBUG", record["level"])
+	require.Equal(t, "GET", record["method"])
+	require.Equal(t, apiVerPath+"account/balance", record["path"])
+	require.Equal(t, float64(200), record["status"])
+	require.Equal(t, `{"data":{"balance":12.34}}`, record["response_body"])
+	headers := record["request_headers"].(map[string]interface{})
+	require.Equal(t, []interface{}{redacted}, headers["Signature"])
+}
+
+func TestLoggerFailure(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	buf := setupLogger(t, client, LogConfig{LogBody: true, ErrorLevel: slog.LevelWarn})
+
+	mux.HandleFunc(apiVerPath+"transfers_in", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"errors":[{"code":422,"message":"Invalid auth code"}]}`))
+	})
+
+	_, err := client.InitTransferIn(&InitTransferInOptions{Domain: "test.com", AuthCode: "secret-code"})
+	require.Error(t, err)
+
+	record := decodeLogRecord(t, buf)
+	require.Equal(t, "WARN", record["level"])
+	require.Equal(t, float64(422), record["status"])
+	require.Equal(t, []interface{}{float64(422)}, record["error_codes"])
+	require.NotContains(t, buf.String(), "secret-code")
+	require.Contains(t, record["request_body"], redacted)
+}
+
+func TestRedactBody(t *testing.T) {
+	require.Equal(t, `{"domain":"test.com","nested":[{"auth_code":"[REDACTED]"}]}`, redactBody([]byte(`{"domain":"test.com","nested":[{"auth_code":"x"}]}`)))
+	require.Equal(t, "plain text", redactBody([]byte("plain text\n")))
+}
@@ -0,0 +1,240 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Represents a manager of several merchant accounts
+// It routes domain operations to the account owning the domain
+// and aggregates read operations across all accounts. It's safe for concurrent use
+type Manager struct {
+	mu       sync.RWMutex
+	accounts map[string]*Client
+	owners   map[string]string
+}
+
+// Represents an error of the single account in aggregated operation
+type AccountError struct {
+	Account string
+	Err     error
+}
+
+func (e *AccountError) Error() string {
+	return fmt.Sprintf("account %s: %v", e.Account, e.Err)
+}
+
+func (e *AccountError) Unwrap() error {
+	return e.Err
+}
+
+// Creates a new manager without accounts
+func NewManager() *Manager {
+	return &Manager{
+		accounts: make(map[string]*Client),
+		owners:   make(map[string]string),
+	}
+}
+
+// AddAccount Add named account client to the manager
+func (m *Manager) AddAccount(name string, client *Client) error {
+	if name == "" || client == nil {
+		return errors.New("manager: account name and client are required")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.accounts[name]; ok {
+		return fmt.Errorf("manager: account %s already exists", name)
+	}
+	m.accounts[name] = client
+	return nil
+}
+
+// RemoveAccount Remove account and forget its domains
+func (m *Manager) RemoveAccount(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.accounts, name)
+	for domain, owner := range m.owners {
+		if owner == name {
+			delete(m.owners, domain)
+		}
+	}
+}
+
+// Account returns client of the named account
+func (m *Manager) Account(name string) (*Client, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	c, ok := m.accounts[name]
+	return c, ok
+}
+
+// Accounts returns sorted names of all accounts
+func (m *Manager) Accounts() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	names := make([]string, 0, len(m.accounts))
+	for name := range m.accounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ClientForDomain returns client and name of the account owning the domain
+// Unknown domains are looked up in all accounts via GetDomains() and cached.
+// Returns ErrNotFound if no account owns the domain
+func (m *Manager) ClientForDomain(ctx context.Context, domain string) (*Client, string, error) {
+	key := strings.ToLower(strings.TrimSuffix(domain, "."))
+	if c, name, ok := m.cached(key); ok {
+		return c, name, nil
+	}
+
+	err := m.forEach(ctx, func(ctx context.Context, name string, c *Client) error {
+		domains, err := c.GetAllDomains(ctx, &GetDomainsOptions{DomainLike: key}, 0)
+		if err != nil {
+			return err
+		}
+		m.remember(name, domains)
+		return nil
+	})
+
+	if c, name, ok := m.cached(key); ok {
+		return c, name, nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return nil, "", fmt.Errorf("domain %s is not owned by any account: %w", domain, ErrNotFound)
+}
+
+// Refresh Rebuild the cache of domain owners listing domains of all accounts
+func (m *Manager) Refresh(ctx context.Context) error {
+	owners := make(map[string]string)
+	var mu sync.Mutex
+	err := m.forEach(ctx, func(ctx context.Context, name string, c *Client) error {
+		domains, err := c.GetAllDomains(ctx, nil, 0)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, d := range domains {
+			owners[strings.ToLower(d.Domain)] = name
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.owners = owners
+	return nil
+}
+
+// Forget Remove the domain from the cache of domain owners
+// Should be called after the domain is deleted or transferred out
+func (m *Manager) Forget(domain string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.owners, strings.ToLower(strings.TrimSuffix(domain, ".")))
+}
+
+// GetAccountBalances Get balances of all accounts by account name
+// Balances of successful accounts are returned along with errors of failed ones
+func (m *Manager) GetAccountBalances(ctx context.Context) (map[string]*Balance, error) {
+	result := make(map[string]*Balance)
+	var mu sync.Mutex
+	err := m.forEach(ctx, func(ctx context.Context, name string, c *Client) error {
+		b, err := c.GetAccountBalanceCtx(ctx)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		result[name] = b
+		return nil
+	})
+	return result, err
+}
+
+// GetAllDomains Get domains of all accounts by account name and cache their owners
+// Domains of successful accounts are returned along with errors of failed ones
+func (m *Manager) GetAllDomains(ctx context.Context, opt *GetDomainsOptions) (map[string][]*Domain, error) {
+	result := make(map[string][]*Domain)
+	var mu sync.Mutex
+	err := m.forEach(ctx, func(ctx context.Context, name string, c *Client) error {
+		domains, err := c.GetAllDomains(ctx, opt, 0)
+		if err != nil {
+			return err
+		}
+		m.remember(name, domains)
+		mu.Lock()
+		defer mu.Unlock()
+		result[name] = domains
+		return nil
+	})
+	return result, err
+}
+
+// Get cached owner of the domain
+func (m *Manager) cached(domain string) (*Client, string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	name, ok := m.owners[domain]
+	if !ok {
+		return nil, "", false
+	}
+	c, ok := m.accounts[name]
+	return c, name, ok
+}
+
+// Cache the account as owner of the domains
+func (m *Manager) remember(name string, domains []*Domain) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.accounts[name]; !ok {
+		return
+	}
+	for _, d := range domains {
+		m.owners[strings.ToLower(d.Domain)] = name
+	}
+}
+
+// Run fn for every account concurrently, errors are joined as *AccountError
+func (m *Manager) forEach(ctx context.Context, fn func(ctx context.Context, name string, c *Client) error) error {
+	m.mu.RLock()
+	accounts := make(map[string]*Client, len(m.accounts))
+	for name, c := range m.accounts {
+		accounts[name] = c
+	}
+	m.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	for name, c := range accounts {
+		wg.Add(1)
+		go func(name string, c *Client) {
+			defer wg.Done()
+			if err := fn(ctx, name, c); err != nil {
+				mu.Lock()
+				errs = append(errs, &AccountError{Account: name, Err: err})
+				mu.Unlock()
+			}
+		}(name, c)
+	}
+	wg.Wait()
+
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].(*AccountError).Account < errs[j].(*AccountError).Account
+	})
+	return errors.Join(errs...)
+}
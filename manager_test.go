@@ -0,0 +1,121 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// setup a test http server serving domains and balance of an account
+func setupAccount(t *testing.T, domains []string, balance string) (*Client, *int32, func()) {
+	mux, server, client := setup(t)
+	var listCalls int32
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&listCalls, 1)
+		like := r.URL.Query().Get("domain_like")
+		data := ""
+		for _, d := range domains {
+			if like != "" && d != like {
+				continue
+			}
+			if data != "" {
+				data += ","
+			}
+			data += fmt.Sprintf(`{"domain":%q}`, d)
+		}
+		fmt.Fprintf(w, `{"meta":{"current_page":1,"per_page":30,"total_pages":1},"data":[%s]}`, data)
+	})
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		if balance == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"errors":[{"code":401,"message":"Unauthorized"}]}`))
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"balance":%s}}`, balance)
+	})
+	return client, &listCalls, func() { teardown(server) }
+}
+
+func TestManagerClientForDomain(t *testing.T) {
+	retail, retailCalls, closeRetail := setupAccount(t, []string{"shop.com", "store.com"}, "10")
+	defer closeRetail()
+	media, _, closeMedia := setupAccount(t, []string{"news.com"}, "20")
+	defer closeMedia()
+
+	m := NewManager()
+	require.NoError(t, m.AddAccount("retail", retail))
+	require.NoError(t, m.AddAccount("media", media))
+	require.Error(t, m.AddAccount("media", media))
+	require.Equal(t, []string{"media", "retail"}, m.Accounts())
+
+	c, name, err := m.ClientForDomain(context.Background(), "Shop.com.")
+	require.NoError(t, err)
+	require.Equal(t, "retail", name)
+	require.Same(t, retail, c)
+
+	// cached, no more lookups
+	calls := atomic.LoadInt32(retailCalls)
+	_, name, err = m.ClientForDomain(context.Background(), "shop.com")
+	require.NoError(t, err)
+	require.Equal(t, "retail", name)
+	require.Equal(t, calls, atomic.LoadInt32(retailCalls))
+
+	_, _, err = m.ClientForDomain(context.Background(), "unknown.com")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	m.RemoveAccount("retail")
+	_, _, err = m.ClientForDomain(context.Background(), "shop.com")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestManagerRefresh(t *testing.T) {
+	retail, retailCalls, closeRetail := setupAccount(t, []string{"shop.com", "store.com"}, "10")
+	defer closeRetail()
+
+	m := NewManager()
+	require.NoError(t, m.AddAccount("retail", retail))
+	require.NoError(t, m.Refresh(context.Background()))
+	require.Equal(t, int32(1), atomic.LoadInt32(retailCalls))
+
+	_, name, err := m.ClientForDomain(context.Background(), "store.com")
+	require.NoError(t, err)
+	require.Equal(t, "retail", name)
+	require.Equal(t, int32(1), atomic.LoadInt32(retailCalls))
+
+	m.Forget("store.com")
+	_, _, err = m.ClientForDomain(context.Background(), "store.com")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(retailCalls))
+}
+
+func TestManagerAggregate(t *testing.T) {
+	retail, _, closeRetail := setupAccount(t, []string{"shop.com"}, "10.5")
+	defer closeRetail()
+	media, _, closeMedia := setupAccount(t, []string{"news.com", "tv.com"}, "")
+	defer closeMedia()
+
+	m := NewManager()
+	require.NoError(t, m.AddAccount("retail", retail))
+	require.NoError(t, m.AddAccount("media", media))
+
+	balances, err := m.GetAccountBalances(context.Background())
+	require.ErrorIs(t, err, ErrUnauthorized)
+	var accErr *AccountError
+	require.True(t, errors.As(err, &accErr))
+	require.Equal(t, "media", accErr.Account)
+	require.Equal(t, map[string]*Balance{"retail": {MustParseMoney("10.5", "")}}, balances)
+
+	domains, err := m.GetAllDomains(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, domains["retail"], 1)
+	require.Len(t, domains["media"], 2)
+
+	_, name, err := m.ClientForDomain(context.Background(), "tv.com")
+	require.NoError(t, err)
+	require.Equal(t, "media", name)
+}
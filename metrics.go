@@ -0,0 +1,224 @@
+package pananames
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default latency histogram buckets in seconds
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// Represents metrics of a single API request
+type RequestMetrics struct {
+	// API operation name, e.g. RenewDomain, "unknown" for raw calls
+	Operation string
+	// HTTP method
+	Method string
+	// Status class: 2xx, 4xx, 5xx or "error" if no response was received
+	StatusClass string
+	// Code of the first API error, 0 if none
+	APIErrorCode int
+	// Request duration including retries
+	Duration time.Duration
+}
+
+// Represents a collector of request metrics
+// Implementations must be safe for concurrent use
+type MetricsCollector interface {
+	ObserveRequest(m RequestMetrics)
+}
+
+// Represents a MetricsCollector keeping counters and latency histograms in memory
+// It renders metrics in Prometheus text exposition format
+type PrometheusCollector struct {
+	mu         sync.Mutex
+	buckets    []float64
+	requests   map[requestsKey]uint64
+	histograms map[durationKey]*histogram
+}
+
+// Labels of requests counter
+type requestsKey struct {
+	operation, method, statusClass string
+	errorCode                      int
+}
+
+// Labels of latency histogram
+type durationKey struct {
+	operation, method, statusClass string
+}
+
+// Represents a latency histogram with non cumulative bucket counts
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// WithMetrics Collect metrics of every request of api client
+func WithMetrics(collector MetricsCollector) Option {
+	return func(c *Client) error {
+		c.metrics = collector
+		return nil
+	}
+}
+
+// Creates a new collector with the latency buckets in seconds
+// DefaultLatencyBuckets are used if no buckets passed
+func NewPrometheusCollector(buckets ...float64) (*PrometheusCollector, error) {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	b := append([]float64(nil), buckets...)
+	for i := 1; i < len(b); i++ {
+		if b[i] <= b[i-1] {
+			return nil, errors.New("metrics: buckets must be sorted in increasing order")
+		}
+	}
+	return &PrometheusCollector{
+		buckets:    b,
+		requests:   make(map[requestsKey]uint64),
+		histograms: make(map[durationKey]*histogram),
+	}, nil
+}
+
+// ObserveRequest Count the request and add its duration to the histogram
+func (p *PrometheusCollector) ObserveRequest(m RequestMetrics) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests[requestsKey{m.Operation, m.Method, m.StatusClass, m.APIErrorCode}]++
+
+	key := durationKey{m.Operation, m.Method, m.StatusClass}
+	h, ok := p.histograms[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.histograms[key] = h
+	}
+	secs := m.Duration.Seconds()
+	for i, le := range p.buckets {
+		if secs <= le {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += secs
+}
+
+// WritePrometheus Write metrics in Prometheus text exposition format
+func (p *PrometheusCollector) WritePrometheus(w io.Writer) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "# HELP pananames_requests_total Total number of Pananames API requests.")
+	fmt.Fprintln(bw, "# TYPE pananames_requests_total counter")
+	reqKeys := make([]requestsKey, 0, len(p.requests))
+	for k := range p.requests {
+		reqKeys = append(reqKeys, k)
+	}
+	sort.Slice(reqKeys, func(i, j int) bool {
+		a, b := reqKeys[i], reqKeys[j]
+		if a.operation != b.operation {
+			return a.operation < b.operation
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		if a.statusClass != b.statusClass {
+			return a.statusClass < b.statusClass
+		}
+		return a.errorCode < b.errorCode
+	})
+	for _, k := range reqKeys {
+		fmt.Fprintf(bw, "pananames_requests_total{%s,error_code=\"%d\"} %d\n", formatLabels(k.operation, k.method, k.statusClass), k.errorCode, p.requests[k])
+	}
+
+	fmt.Fprintln(bw, "# HELP pananames_request_duration_seconds Duration of Pananames API requests.")
+	fmt.Fprintln(bw, "# TYPE pananames_request_duration_seconds histogram")
+	durKeys := make([]durationKey, 0, len(p.histograms))
+	for k := range p.histograms {
+		durKeys = append(durKeys, k)
+	}
+	sort.Slice(durKeys, func(i, j int) bool {
+		a, b := durKeys[i], durKeys[j]
+		if a.operation != b.operation {
+			return a.operation < b.operation
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		return a.statusClass < b.statusClass
+	})
+	for _, k := range durKeys {
+		h := p.histograms[k]
+		labels := formatLabels(k.operation, k.method, k.statusClass)
+		var cumulative uint64
+		for i, le := range p.buckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(bw, "pananames_request_duration_seconds_bucket{%s,le=\"%s\"} %d\n", labels, strconv.FormatFloat(le, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(bw, "pananames_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, h.count)
+		fmt.Fprintf(bw, "pananames_request_duration_seconds_sum{%s} %s\n", labels, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(bw, "pananames_request_duration_seconds_count{%s} %d\n", labels, h.count)
+	}
+
+	return bw.Flush()
+}
+
+// ServeHTTP Serve metrics for Prometheus scraping
+func (p *PrometheusCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = p.WritePrometheus(w)
+}
+
+// Format common labels of the metrics
+func formatLabels(operation, method, statusClass string) string {
+	return fmt.Sprintf(`operation="%s",method="%s",status_class="%s"`, escapeLabel(operation), escapeLabel(method), escapeLabel(statusClass))
+}
+
+// Escape label value according to the exposition format
+func escapeLabel(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
+
+// Get status class of the response, e.g. 2xx
+func statusClass(resp *http.Response) string {
+	if resp == nil {
+		return "error"
+	}
+	return strconv.Itoa(resp.StatusCode/100) + "xx"
+}
+
+// Build middleware reporting request metrics to the collector
+func (c *Client) metricsMiddleware(next Doer) Doer {
+	collector := c.metrics
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next.Do(req)
+
+		m := RequestMetrics{
+			Operation:   operationName(req),
+			Method:      req.Method,
+			StatusClass: statusClass(resp),
+			Duration:    time.Since(start),
+		}
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) && len(errResp.Errors) > 0 {
+			m.APIErrorCode = errResp.Errors[0].Code
+		}
+		collector.ObserveRequest(m)
+
+		return resp, err
+	})
+}
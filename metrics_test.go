@@ -0,0 +1,86 @@
+package pananames
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	collector, err := NewPrometheusCollector(0.5, 1)
+	require.NoError(t, err)
+	require.NoError(t, client.parseOptions(WithMetrics(collector)))
+
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "balance.json")
+	})
+	mux.HandleFunc(apiVerPath+"domains/test.com/check", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"errors":[{"code":4001,"message":"Invalid domain"}]}`))
+	})
+
+	_, err = client.GetAccountBalance()
+	require.NoError(t, err)
+	_, err = client.GetAccountBalance()
+	require.NoError(t, err)
+	_, err = client.CheckDomain("test.com")
+	require.Error(t, err)
+	// raw calls don't put domain names into labels
+	_, err = Call[struct{}](client, context.Background(), http.MethodGet, "account/balance", nil)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(2), collector.requests[requestsKey{"GetAccountBalance", http.MethodGet, "2xx", 0}])
+	require.Equal(t, uint64(1), collector.requests[requestsKey{"CheckDomain", http.MethodGet, "4xx", 4001}])
+	require.Equal(t, uint64(1), collector.requests[requestsKey{"unknown", http.MethodGet, "2xx", 0}])
+	require.Equal(t, uint64(2), collector.histograms[durationKey{"GetAccountBalance", http.MethodGet, "2xx"}].count)
+}
+
+func TestWritePrometheus(t *testing.T) {
+	collector, err := NewPrometheusCollector(0.5, 1)
+	require.NoError(t, err)
+
+	collector.ObserveRequest(RequestMetrics{Operation: "GetDomain", Method: "GET", StatusClass: "2xx", Duration: 250 * time.Millisecond})
+	collector.ObserveRequest(RequestMetrics{Operation: "GetDomain", Method: "GET", StatusClass: "2xx", Duration: 750 * time.Millisecond})
+	collector.ObserveRequest(RequestMetrics{Operation: "GetDomain", Method: "GET", StatusClass: "4xx", APIErrorCode: 404, Duration: 2 * time.Second})
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, collector.WritePrometheus(buf))
+	want := `# HELP pananames_requests_total Total number of Pananames API requests.
+# TYPE pananames_requests_total counter
+pananames_requests_total{operation="GetDomain",method="GET",status_class="2xx",error_code="0"} 2
+pananames_requests_total{operation="GetDomain",method="GET",status_class="4xx",error_code="404"} 1
+# HELP pananames_request_duration_seconds Duration of Pananames API requests.
+# TYPE pananames_request_duration_seconds histogram
+pananames_request_duration_seconds_bucket{operation="GetDomain",method="GET",status_class="2xx",le="0.5"} 1
+pananames_request_duration_seconds_bucket{operation="GetDomain",method="GET",status_class="2xx",le="1"} 2
+pananames_request_duration_seconds_bucket{operation="GetDomain",method="GET",status_class="2xx",le="+Inf"} 2
+pananames_request_duration_seconds_sum{operation="GetDomain",method="GET",status_class="2xx"} 1
+pananames_request_duration_seconds_count{operation="GetDomain",method="GET",status_class="2xx"} 2
+pananames_request_duration_seconds_bucket{operation="GetDomain",method="GET",status_class="4xx",le="0.5"} 0
+pananames_request_duration_seconds_bucket{operation="GetDomain",method="GET",status_class="4xx",le="1"} 0
+pananames_request_duration_seconds_bucket{operation="GetDomain",method="GET",status_class="4xx",le="+Inf"} 1
+pananames_request_duration_seconds_sum{operation="GetDomain",method="GET",status_class="4xx"} 2
+pananames_request_duration_seconds_count{operation="GetDomain",method="GET",status_class="4xx"} 1
+`
+	require.Equal(t, want, buf.String())
+}
+
+func TestNewPrometheusCollectorBuckets(t *testing.T) {
+	_, err := NewPrometheusCollector(1, 0.5)
+	require.Error(t, err)
+
+	c, err := NewPrometheusCollector()
+	require.NoError(t, err)
+	require.Equal(t, DefaultLatencyBuckets, c.buckets)
+}
+
+func TestEscapeLabel(t *testing.T) {
+	require.Equal(t, `GET /a\"b\\c\n`, escapeLabel("GET /a\"b\\c\n"))
+}
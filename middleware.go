@@ -0,0 +1,52 @@
+package pananames
+
+import "net/http"
+
+// Represents anything that can execute an API request, *http.Client satisfies it
+// The returned error is *ErrorResponse if the API responds with an error status
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Represents an adapter to use ordinary function as Doer
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Represents a middleware wrapping the request execution of api client
+type Middleware func(next Doer) Doer
+
+// Do calls f(req)
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// WithMiddleware Add middlewares around request execution of api client
+// The first middleware is the outermost one. Middlewares see every call once,
+// after retries and rate limiting, before the response data is decoded
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(c *Client) error {
+		for _, mw := range middlewares {
+			if mw != nil {
+				c.middlewares = append(c.middlewares, mw)
+			}
+		}
+		return nil
+	}
+}
+
+// Build the request execution chain from the registered middlewares
+func (c *Client) doer() Doer {
+	var d Doer = DoerFunc(c.send)
+	if c.logger != nil {
+		d = c.loggingMiddleware(d)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		d = c.middlewares[i](d)
+	}
+	if c.metrics != nil {
+		d = c.metricsMiddleware(d)
+	}
+	if c.tracer != nil {
+		d = c.tracingMiddleware(d)
+	}
+	return d
+}
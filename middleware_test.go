@@ -0,0 +1,82 @@
+package pananames
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var calls []string
+	tag := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next.Do(req)
+				calls = append(calls, name+" after")
+				return resp, err
+			})
+		}
+	}
+	require.NoError(t, client.parseOptions(WithMiddleware(tag("outer"), tag("inner"))))
+
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "balance.json")
+	})
+
+	_, err := client.GetAccountBalance()
+	require.NoError(t, err)
+	require.Equal(t, []string{"outer before", "inner before", "inner after", "outer after"}, calls)
+}
+
+func TestMiddlewareObservesError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var gotErr error
+	var gotStatus int
+	require.NoError(t, client.parseOptions(WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.Do(req)
+			gotErr = err
+			gotStatus = resp.StatusCode
+			return resp, err
+		})
+	})))
+
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":404,"message":"Not found"}]}`))
+	})
+
+	_, err := client.GetDomain("test.com")
+	require.Error(t, err)
+	var errResp *ErrorResponse
+	require.True(t, errors.As(gotErr, &errResp))
+	require.Equal(t, 404, errResp.Errors[0].Code)
+	require.Equal(t, http.StatusNotFound, gotStatus)
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	client, err := NewClient("secret", WithBaseURL("http://127.0.0.1:0"), WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"data":{"balance":1.5}}`)),
+				Request:    req,
+			}, nil
+		})
+	}))
+	require.NoError(t, err)
+
+	got, err := client.GetAccountBalance()
+	require.NoError(t, err)
+	require.Equal(t, &Balance{MustParseMoney("1.5", "")}, got)
+}
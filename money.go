@@ -0,0 +1,252 @@
+package pananames
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Number of decimal places kept by Money
+const moneyScale = 6
+
+// Maximum number of integer digits of Money
+const moneyIntDigits = 12
+
+var moneyUnit = pow10(moneyScale)
+
+// ErrCurrencyMismatch is returned by Money operations on different currencies
+var ErrCurrencyMismatch = errors.New("pananames: currency mismatch")
+
+// Represents an exact decimal amount of money with up to 6 decimal places
+// An empty currency is compatible with any currency, e.g. for balances and payments
+// which the API returns without currency. It's encoded in JSON as a number
+type Money struct {
+	micros   int64
+	Currency string
+}
+
+// ParseMoney Parse decimal amount like "9.79", "-0.5" or "1e3" in the currency
+// Amounts with more than 6 decimal places are an error
+func ParseMoney(amount, currency string) (Money, error) {
+	micros, err := parseMicros(amount, false)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{micros: micros, Currency: currency}, nil
+}
+
+// MustParseMoney Same as ParseMoney() but panics on error, for constants and tests
+func MustParseMoney(amount, currency string) Money {
+	m, err := ParseMoney(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Amount returns the decimal amount without currency, e.g. "9.79"
+func (m Money) Amount() string {
+	micros := m.micros
+	sign := ""
+	if micros < 0 {
+		sign = "-"
+		micros = -micros
+	}
+	s := sign + strconv.FormatInt(micros/moneyUnit, 10)
+	if frac := micros % moneyUnit; frac != 0 {
+		s += "." + strings.TrimRight(fmt.Sprintf("%0*d", moneyScale, frac), "0")
+	}
+	return s
+}
+
+// String returns the amount with currency, e.g. "9.79 usd"
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount()
+	}
+	return m.Amount() + " " + m.Currency
+}
+
+// Float64 returns the amount as float64, it may lose precision
+func (m Money) Float64() float64 {
+	return float64(m.micros) / float64(moneyUnit)
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.micros == 0
+}
+
+// Sign returns -1, 0 or +1 for negative, zero and positive amounts
+func (m Money) Sign() int {
+	switch {
+	case m.micros < 0:
+		return -1
+	case m.micros > 0:
+		return 1
+	}
+	return 0
+}
+
+// Add returns m + o, the currencies must match
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := m.currency(o)
+	if err != nil {
+		return Money{}, err
+	}
+	sum := m.micros + o.micros
+	if (sum > m.micros) != (o.micros > 0) {
+		return Money{}, errors.New("money: overflow")
+	}
+	return Money{micros: sum, Currency: currency}, nil
+}
+
+// Sub returns m - o, the currencies must match
+func (m Money) Sub(o Money) (Money, error) {
+	return m.Add(o.Neg())
+}
+
+// Mul returns m * n, e.g. price for n years
+func (m Money) Mul(n int64) (Money, error) {
+	if n != 0 && (m.micros*n)/n != m.micros {
+		return Money{}, errors.New("money: overflow")
+	}
+	return Money{micros: m.micros * n, Currency: m.Currency}, nil
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return Money{micros: -m.micros, Currency: m.Currency}
+}
+
+// Cmp returns -1, 0 or +1 if m is less than, equal to or greater than o, the currencies must match
+func (m Money) Cmp(o Money) (int, error) {
+	if _, err := m.currency(o); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.micros < o.micros:
+		return -1, nil
+	case m.micros > o.micros:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Equal reports whether amounts and currencies are equal, currencies are compared case-insensitively
+func (m Money) Equal(o Money) bool {
+	return m.micros == o.micros && strings.EqualFold(m.Currency, o.Currency)
+}
+
+// MarshalJSON encodes the amount as JSON number
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.Amount()), nil
+}
+
+// UnmarshalJSON decodes the amount from JSON number or string, the currency is kept
+// Amounts with more than 6 decimal places are rounded half away from zero
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if len(data) == 0 || string(data) == "null" {
+		m.micros = 0
+		return nil
+	}
+	micros, err := parseMicros(string(data), true)
+	if err != nil {
+		return err
+	}
+	m.micros = micros
+	return nil
+}
+
+// Currency of the result of operation on m and o
+func (m Money) currency(o Money) (string, error) {
+	switch {
+	case m.Currency == "":
+		return o.Currency, nil
+	case o.Currency == "" || strings.EqualFold(m.Currency, o.Currency):
+		return m.Currency, nil
+	}
+	return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+}
+
+// Decimal number with optional exponent, e.g. "9.79", "-1e2" or "2.5E-1"
+var moneyNumber = regexp.MustCompile(`^([+-]?)(\d+)(?:\.(\d*))?(?:[eE]([+-]?\d+))?$`)
+
+// Parse decimal amount into millionths
+// More decimal places are rounded half away from zero if round is set, otherwise it's an error
+func parseMicros(s string, round bool) (int64, error) {
+	m := moneyNumber.FindStringSubmatch(s)
+	if m == nil {
+		return 0, fmt.Errorf("money: invalid amount %q", s)
+	}
+	digits := strings.TrimLeft(m[2]+m[3], "0")
+	if digits == "" {
+		return 0, nil
+	}
+	exp := 0
+	if m[4] != "" {
+		// Beyond the limit the amount overflows or rounds to zero, clamp it to keep the arithmetic below in range
+		limit := moneyIntDigits + moneyScale + len(m[2]) + len(m[3])
+		exp64, _ := strconv.ParseInt(m[4], 10, 64)
+		switch {
+		case exp64 > int64(limit):
+			exp = limit + 1
+		case exp64 < -int64(limit):
+			exp = -limit - 1
+		default:
+			exp = int(exp64)
+		}
+	}
+
+	// The amount is digits * 10^shift millionths
+	shift := exp - len(m[3]) + moneyScale
+	up := false
+	if shift >= 0 {
+		if len(digits)+shift > moneyIntDigits+moneyScale {
+			return 0, fmt.Errorf("money: amount %q out of range", s)
+		}
+		digits += strings.Repeat("0", shift)
+	} else {
+		cut := len(digits) + shift
+		kept, dropped := "", digits
+		if cut > 0 {
+			kept, dropped = digits[:cut], digits[cut:]
+		}
+		if !round && strings.Trim(dropped, "0") != "" {
+			return 0, fmt.Errorf("money: amount %q has more than %d decimal places", s, moneyScale)
+		}
+		// Dropped digits start with zeros if cut is negative
+		up = cut >= 0 && dropped[0] >= '5'
+		digits = kept
+	}
+	if len(digits) > moneyIntDigits+moneyScale {
+		return 0, fmt.Errorf("money: amount %q out of range", s)
+	}
+
+	var micros int64
+	if digits != "" {
+		micros, _ = strconv.ParseInt(digits, 10, 64)
+	}
+	if up {
+		micros++
+	}
+	if micros >= pow10(moneyIntDigits+moneyScale) {
+		return 0, fmt.Errorf("money: amount %q out of range", s)
+	}
+	if m[1] == "-" {
+		micros = -micros
+	}
+	return micros, nil
+}
+
+func pow10(n int) int64 {
+	p := int64(1)
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
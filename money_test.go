@@ -0,0 +1,141 @@
+package pananames
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// prices in usd as decoded from the API
+func usdPrices(register, renew, transfer, redeem string) *Prices {
+	return &Prices{
+		Currency: "usd",
+		Register: MustParseMoney(register, "usd"),
+		Renew:    MustParseMoney(renew, "usd"),
+		Transfer: MustParseMoney(transfer, "usd"),
+		Redeem:   MustParseMoney(redeem, "usd"),
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		amount string
+		want   string
+	}{
+		{"9.79", "9.79"},
+		{"10", "10"},
+		{"10.500", "10.5"},
+		{"-0.01", "-0.01"},
+		{"0.000001", "0.000001"},
+		{"999999999999.999999", "999999999999.999999"},
+		{"1e3", "1000"},
+		{"2.5E-1", "0.25"},
+		{"-979e-2", "-9.79"},
+		{"1.2300000000", "1.23"},
+		{"0e999999999999", "0"},
+	}
+	for _, tt := range tests {
+		m, err := ParseMoney(tt.amount, "usd")
+		require.NoError(t, err, tt.amount)
+		require.Equal(t, tt.want, m.Amount())
+	}
+
+	for _, amount := range []string{"", "1.0000001", "1e-7", "abc", "1,5", "--1", ".5", "0x10", "1000000000000", "1e12", "1e999999999999"} {
+		_, err := ParseMoney(amount, "usd")
+		require.Error(t, err, amount)
+	}
+	require.Panics(t, func() { MustParseMoney("x", "") })
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	a := MustParseMoney("0.1", "usd")
+	b := MustParseMoney("0.2", "USD")
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	require.True(t, sum.Equal(MustParseMoney("0.3", "usd")))
+	require.Equal(t, "0.3 usd", sum.String())
+
+	diff, err := a.Sub(b)
+	require.NoError(t, err)
+	require.Equal(t, -1, diff.Sign())
+	require.Equal(t, "-0.1", diff.Amount())
+
+	total, err := MustParseMoney("9.79", "usd").Mul(3)
+	require.NoError(t, err)
+	require.Equal(t, "29.37", total.Amount())
+
+	cmp, err := a.Cmp(b)
+	require.NoError(t, err)
+	require.Equal(t, -1, cmp)
+
+	// empty currency is compatible with any currency
+	sum, err = MustParseMoney("1", "").Add(a)
+	require.NoError(t, err)
+	require.Equal(t, "1.1 usd", sum.String())
+
+	_, err = a.Add(MustParseMoney("1", "eur"))
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = a.Cmp(MustParseMoney("1", "eur"))
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	max := MustParseMoney("999999999999", "")
+	_, err = max.Mul(10)
+	require.Error(t, err)
+	_, err = Money{micros: 1 << 62}.Add(Money{micros: 1 << 62})
+	require.Error(t, err)
+}
+
+func TestMoneyJSON(t *testing.T) {
+	var p Prices
+	require.NoError(t, json.Unmarshal([]byte(`{"currency":"usd","register":0.1,"renew":"0.20","transfer":null}`), &p))
+	require.Equal(t, *usdPrices("0.1", "0.2", "0", "0"), p)
+
+	sum, err := p.Register.Add(p.Renew)
+	require.NoError(t, err)
+	require.Equal(t, "0.3", sum.Amount())
+
+	// any JSON number is decoded, more decimal places are rounded
+	numbers := []struct {
+		json string
+		want string
+	}{
+		{`1e1`, "10"},
+		{`9.7900001`, "9.79"},
+		{`9.7900005`, "9.790001"},
+		{`-0.0000005`, "-0.000001"},
+		{`"1.2345674"`, "1.234567"},
+		{`1E-7`, "0"},
+		{`1e-400`, "0"},
+		{`1e-9223372036854775808`, "0"},
+		{`0.000000000000000000000001e24`, "1"},
+		{`0.9999999`, "1"},
+	}
+	for _, tt := range numbers {
+		var m Money
+		require.NoError(t, json.Unmarshal([]byte(tt.json), &m), tt.json)
+		require.Equal(t, tt.want, m.Amount(), tt.json)
+	}
+
+	var m Money
+	require.Error(t, json.Unmarshal([]byte(`1e400`), &m))
+	require.Error(t, json.Unmarshal([]byte(`1e9223372036854775801`), &m))
+	require.Error(t, json.Unmarshal([]byte(`1e9223372036854775807`), &m))
+	require.Error(t, json.Unmarshal([]byte(`1e99999999999999999999`), &m))
+	require.Error(t, json.Unmarshal([]byte(`"abc"`), &m))
+
+	// one odd price doesn't break the whole response
+	var odd Prices
+	require.NoError(t, json.Unmarshal([]byte(`{"currency":"usd","register":9.7900001,"renew":1e1}`), &odd))
+	require.Equal(t, "9.79 usd", odd.Register.String())
+	require.Equal(t, "10 usd", odd.Renew.String())
+
+	data, err := json.Marshal(&RenewDomainOptions{Period: "1", PremiumPrice: &p.Register})
+	require.NoError(t, err)
+	require.Equal(t, `{"period":"1","premium_price":0.1}`, string(data))
+
+	data, err = json.Marshal(&RenewDomainOptions{Period: "1"})
+	require.NoError(t, err)
+	require.Equal(t, `{"period":"1"}`, string(data))
+}
@@ -1,6 +1,7 @@
 package pananames
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -58,278 +59,264 @@ type EnableDNSSecOptions struct {
 
 // Get name servers list for the domain
 func (c *Client) GetNameServers(domain string, options ...RequestOptionFunc) (*NameServers, error) {
-	u := fmt.Sprintf("domains/%s/name_servers", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(NameServers)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetNameServersCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as GetNameServers() with the request context
+func (c *Client) GetNameServersCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*NameServers, error) {
+	u := fmt.Sprintf("domains/%s/name_servers", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("GetNameServers", domain)}, options...)
+	return Call[*NameServers](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Set name servers for the domain
 func (c *Client) SetNameServers(domain string, opt *SetNameServersOptions, options ...RequestOptionFunc) (*NameServers, error) {
+	return c.SetNameServersCtx(context.Background(), domain, opt, options...)
+}
+
+// Same as SetNameServers() with the request context
+func (c *Client) SetNameServersCtx(ctx context.Context, domain string, opt *SetNameServersOptions, options ...RequestOptionFunc) (*NameServers, error) {
 	u := fmt.Sprintf("domains/%s/name_servers", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
+	options = append([]RequestOptionFunc{operation("SetNameServers", domain)}, options...)
+	return Call[*NameServers](c, ctx, http.MethodPut, u, opt, options...)
+}
 
-	result := new(NameServers)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
+// Validate SetNameServersOptions for required options
+func (opt *SetNameServersOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	if len(opt.NameServers) == 0 {
+		e.add("name_servers", "is required")
+	}
+	for i, ns := range opt.NameServers {
+		e.hostname(fmt.Sprintf("name_servers[%d]", i), ns)
 	}
+	return e.err(opt)
+}
 
-	return result, nil
+// Validate ChildNameServerOptions for host name and IP addresses
+func (opt *ChildNameServerOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	e.hostname("hostname", opt.Hostname)
+	if opt.IPv4 == "" && opt.IPv6 == "" {
+		e.add("ipv4", "ipv4 or ipv6 is required")
+	}
+	if opt.IPv4 != "" && !validIP(opt.IPv4, false) {
+		e.add("ipv4", "%q is not a valid IPv4 address", opt.IPv4)
+	}
+	if opt.IPv6 != "" && !validIP(opt.IPv6, true) {
+		e.add("ipv6", "%q is not a valid IPv6 address", opt.IPv6)
+	}
+	return e.err(opt)
 }
 
-// Validate SetNameServersOptions for required options
-func (opt *SetNameServersOptions) Validate() error {
+// Validate DeleteChildNameServerOptions for host name
+func (opt *DeleteChildNameServerOptions) Validate() error {
 	if opt == nil {
-		return fmt.Errorf("%T can't be nil", opt)
+		return nilOptionsError(opt)
 	}
-	return nil
+	var e fieldErrors
+	e.hostname("hostname", opt.Hostname)
+	return e.err(opt)
 }
 
-// Delete name servers for the domain
-func (c *Client) DeleteNameServers(domain string, options ...RequestOptionFunc) error {
-	u := fmt.Sprintf("domains/%s/name_servers", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodDelete, u, nil, options)
-	if err != nil {
-		return err
+// Validate DeleteNameServerRecordsOptions for record ID
+func (opt *DeleteNameServerRecordsOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	if opt.ID == "" {
+		e.add("id", "is required")
 	}
+	return e.err(opt)
+}
 
-	_, err = c.Do(req, nil)
-	if err != nil {
-		return err
+// Validate EnableDNSSecOptions for DS record
+func (opt *EnableDNSSecOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	if opt.DS == "" {
+		e.add("ds", "is required")
 	}
+	return e.err(opt)
+}
+
+// Delete name servers for the domain
+func (c *Client) DeleteNameServers(domain string, options ...RequestOptionFunc) error {
+	return c.DeleteNameServersCtx(context.Background(), domain, options...)
+}
 
+// Same as DeleteNameServers() with the request context
+func (c *Client) DeleteNameServersCtx(ctx context.Context, domain string, options ...RequestOptionFunc) error {
+	u := fmt.Sprintf("domains/%s/name_servers", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("DeleteNameServers", domain)}, options...)
+	_, err := Call[struct{}](c, ctx, http.MethodDelete, u, nil, options...)
 	return err
 }
 
 // Get a list of child name servers for the domain
 func (c *Client) GetChildNameServers(domain string, options ...RequestOptionFunc) ([]*ChildNameServer, error) {
-	u := fmt.Sprintf("domains/%s/child_name_servers", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-
-	var result []*ChildNameServer
-	_, err = c.Do(req, &result)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetChildNameServersCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as GetChildNameServers() with the request context
+func (c *Client) GetChildNameServersCtx(ctx context.Context, domain string, options ...RequestOptionFunc) ([]*ChildNameServer, error) {
+	u := fmt.Sprintf("domains/%s/child_name_servers", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("GetChildNameServers", domain)}, options...)
+	return Call[[]*ChildNameServer](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Create a new child name server for the domain
 func (c *Client) AddChildNameServer(domain string, opt *ChildNameServerOptions, options ...RequestOptionFunc) (*ChildNameServer, error) {
-	u := fmt.Sprintf("domains/%s/child_name_servers", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPost, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(ChildNameServer)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.AddChildNameServerCtx(context.Background(), domain, opt, options...)
+}
 
-	return result, nil
+// Same as AddChildNameServer() with the request context
+func (c *Client) AddChildNameServerCtx(ctx context.Context, domain string, opt *ChildNameServerOptions, options ...RequestOptionFunc) (*ChildNameServer, error) {
+	u := fmt.Sprintf("domains/%s/child_name_servers", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("AddChildNameServer", domain)}, options...)
+	return Call[*ChildNameServer](c, ctx, http.MethodPost, u, opt, options...)
 }
 
 // Update an existing child name server for the domain
 func (c *Client) UpdateChildNameServer(domain string, opt *ChildNameServerOptions, options ...RequestOptionFunc) (*ChildNameServer, error) {
-	u := fmt.Sprintf("domains/%s/child_name_servers", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(ChildNameServer)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.UpdateChildNameServerCtx(context.Background(), domain, opt, options...)
+}
 
-	return result, nil
+// Same as UpdateChildNameServer() with the request context
+func (c *Client) UpdateChildNameServerCtx(ctx context.Context, domain string, opt *ChildNameServerOptions, options ...RequestOptionFunc) (*ChildNameServer, error) {
+	u := fmt.Sprintf("domains/%s/child_name_servers", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("UpdateChildNameServer", domain)}, options...)
+	return Call[*ChildNameServer](c, ctx, http.MethodPut, u, opt, options...)
 }
 
 // Delete a child name server for the domain by name
 func (c *Client) DeleteChildNameServer(domain string, opt *DeleteChildNameServerOptions, options ...RequestOptionFunc) error {
-	u := fmt.Sprintf("domains/%s/child_name_servers", url.PathEscape(domain))
-
-	req, err := c.NewRequest(http.MethodDelete, u, opt, options)
-	if err != nil {
-		return err
-	}
+	return c.DeleteChildNameServerCtx(context.Background(), domain, opt, options...)
+}
 
-	_, err = c.Do(req, nil)
-	if err != nil {
-		return err
-	}
+// Same as DeleteChildNameServer() with the request context
+func (c *Client) DeleteChildNameServerCtx(ctx context.Context, domain string, opt *DeleteChildNameServerOptions, options ...RequestOptionFunc) error {
+	u := fmt.Sprintf("domains/%s/child_name_servers", url.PathEscape(domain))
 
+	options = append([]RequestOptionFunc{operation("DeleteChildNameServer", domain)}, options...)
+	_, err := Call[struct{}](c, ctx, http.MethodDelete, u, opt, options...)
 	return err
 }
 
 // Get name server records list for the domain
 func (c *Client) GetNameServerRecords(domain string, options ...RequestOptionFunc) ([]*NameServerRecord, error) {
-	u := fmt.Sprintf("domains/%s/name_server_records", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-
-	var result []*NameServerRecord
-	_, err = c.Do(req, &result)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetNameServerRecordsCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as GetNameServerRecords() with the request context
+func (c *Client) GetNameServerRecordsCtx(ctx context.Context, domain string, options ...RequestOptionFunc) ([]*NameServerRecord, error) {
+	u := fmt.Sprintf("domains/%s/name_server_records", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("GetNameServerRecords", domain)}, options...)
+	return Call[[]*NameServerRecord](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Create a new name server record for the domain
 func (c *Client) AddNameServerRecord(domain string, opt *NameServerRecord, options ...RequestOptionFunc) (*NameServerRecord, error) {
-	u := fmt.Sprintf("domains/%s/name_server_records", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPost, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(NameServerRecord)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.AddNameServerRecordCtx(context.Background(), domain, opt, options...)
+}
 
-	return result, nil
+// Same as AddNameServerRecord() with the request context
+func (c *Client) AddNameServerRecordCtx(ctx context.Context, domain string, opt *NameServerRecord, options ...RequestOptionFunc) (*NameServerRecord, error) {
+	u := fmt.Sprintf("domains/%s/name_server_records", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("AddNameServerRecord", domain)}, options...)
+	return Call[*NameServerRecord](c, ctx, http.MethodPost, u, opt, options...)
 }
 
 // Update an existing name server record for the domain
 func (c *Client) UpdateNameServerRecord(domain string, opt *NameServerRecord, options ...RequestOptionFunc) (*NameServerRecord, error) {
-	u := fmt.Sprintf("domains/%s/name_server_records", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(NameServerRecord)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.UpdateNameServerRecordCtx(context.Background(), domain, opt, options...)
+}
 
-	return result, nil
+// Same as UpdateNameServerRecord() with the request context
+func (c *Client) UpdateNameServerRecordCtx(ctx context.Context, domain string, opt *NameServerRecord, options ...RequestOptionFunc) (*NameServerRecord, error) {
+	u := fmt.Sprintf("domains/%s/name_server_records", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("UpdateNameServerRecord", domain)}, options...)
+	return Call[*NameServerRecord](c, ctx, http.MethodPut, u, opt, options...)
 }
 
 // Delete a specific name server record for the domain
 func (c *Client) DeleteNameServerRecord(domain string, opt *DeleteNameServerRecordsOptions, options ...RequestOptionFunc) error {
-	u := fmt.Sprintf("domains/%s/name_server_records", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodDelete, u, opt, options)
-	if err != nil {
-		return err
-	}
-
-	_, err = c.Do(req, nil)
-	if err != nil {
-		return err
-	}
+	return c.DeleteNameServerRecordCtx(context.Background(), domain, opt, options...)
+}
 
+// Same as DeleteNameServerRecord() with the request context
+func (c *Client) DeleteNameServerRecordCtx(ctx context.Context, domain string, opt *DeleteNameServerRecordsOptions, options ...RequestOptionFunc) error {
+	u := fmt.Sprintf("domains/%s/name_server_records", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("DeleteNameServerRecord", domain)}, options...)
+	_, err := Call[struct{}](c, ctx, http.MethodDelete, u, opt, options...)
 	return err
 }
 
 // Create a list of new name server records for the domain
 func (c *Client) SetBulkNameServerRecords(domain string, opt []*NameServerRecord, options ...RequestOptionFunc) ([]*NameServerRecord, error) {
-	u := fmt.Sprintf("domains/%s/bulk_name_server_records", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPost, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
-
-	var result []*NameServerRecord
-	_, err = c.Do(req, &result)
-	if err != nil {
-		return nil, err
-	}
+	return c.SetBulkNameServerRecordsCtx(context.Background(), domain, opt, options...)
+}
 
-	return result, nil
+// Same as SetBulkNameServerRecords() with the request context
+func (c *Client) SetBulkNameServerRecordsCtx(ctx context.Context, domain string, opt []*NameServerRecord, options ...RequestOptionFunc) ([]*NameServerRecord, error) {
+	u := fmt.Sprintf("domains/%s/bulk_name_server_records", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("SetBulkNameServerRecords", domain)}, options...)
+	return Call[[]*NameServerRecord](c, ctx, http.MethodPost, u, opt, options...)
 }
 
 // Update list of existing name server records for the domain
 func (c *Client) UpdateBulkNameServerRecords(domain string, opt []*NameServerRecord, options ...RequestOptionFunc) ([]*NameServerRecord, error) {
-	u := fmt.Sprintf("domains/%s/bulk_name_server_records", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
-
-	var result []*NameServerRecord
-	_, err = c.Do(req, &result)
-	if err != nil {
-		return nil, err
-	}
+	return c.UpdateBulkNameServerRecordsCtx(context.Background(), domain, opt, options...)
+}
 
-	return result, nil
+// Same as UpdateBulkNameServerRecords() with the request context
+func (c *Client) UpdateBulkNameServerRecordsCtx(ctx context.Context, domain string, opt []*NameServerRecord, options ...RequestOptionFunc) ([]*NameServerRecord, error) {
+	u := fmt.Sprintf("domains/%s/bulk_name_server_records", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("UpdateBulkNameServerRecords", domain)}, options...)
+	return Call[[]*NameServerRecord](c, ctx, http.MethodPut, u, opt, options...)
 }
 
 // Get DNSSec status for the domain
 func (c *Client) GetDNSSec(domain string, options ...RequestOptionFunc) (*DNSSec, error) {
-	u := fmt.Sprintf("domains/%s/dnssec", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(DNSSec)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetDNSSecCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as GetDNSSec() with the request context
+func (c *Client) GetDNSSecCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*DNSSec, error) {
+	u := fmt.Sprintf("domains/%s/dnssec", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("GetDNSSec", domain)}, options...)
+	return Call[*DNSSec](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Enable DNSSec for the domain
 func (c *Client) EnableDNSSec(domain string, opt *EnableDNSSecOptions, options ...RequestOptionFunc) (*DNSSec, error) {
-	u := fmt.Sprintf("domains/%s/dnssec", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(DNSSec)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.EnableDNSSecCtx(context.Background(), domain, opt, options...)
+}
 
-	return result, nil
+// Same as EnableDNSSec() with the request context
+func (c *Client) EnableDNSSecCtx(ctx context.Context, domain string, opt *EnableDNSSecOptions, options ...RequestOptionFunc) (*DNSSec, error) {
+	u := fmt.Sprintf("domains/%s/dnssec", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("EnableDNSSec", domain)}, options...)
+	return Call[*DNSSec](c, ctx, http.MethodPut, u, opt, options...)
 }
 
 // Disable DNSSec for the domain
 func (c *Client) DisableDNSSec(domain string, options ...RequestOptionFunc) (*DNSSec, error) {
-	u := fmt.Sprintf("domains/%s/dnssec", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodDelete, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(DNSSec)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.DisableDNSSecCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as DisableDNSSec() with the request context
+func (c *Client) DisableDNSSecCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*DNSSec, error) {
+	u := fmt.Sprintf("domains/%s/dnssec", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("DisableDNSSec", domain)}, options...)
+	return Call[*DNSSec](c, ctx, http.MethodDelete, u, nil, options...)
 }
@@ -94,8 +94,8 @@ func TestUpdateChildNameServer(t *testing.T) {
 
 	opts := &ChildNameServerOptions{
 		Hostname: "ns1.test.com",
-		IPv4:     "string",
-		IPv6:     "string",
+		IPv4:     "192.0.2.1",
+		IPv6:     "2001:db8::1",
 	}
 	mux.HandleFunc(apiVerPath+"domains/test.com/child_name_servers", func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodPut, r.Method)
@@ -120,8 +120,8 @@ func TestAddChildNameServer(t *testing.T) {
 
 	opts := &ChildNameServerOptions{
 		Hostname: "ns1.test.com",
-		IPv4:     "string",
-		IPv6:     "string",
+		IPv4:     "192.0.2.1",
+		IPv6:     "2001:db8::1",
 	}
 	mux.HandleFunc(apiVerPath+"domains/test.com/child_name_servers", func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodPost, r.Method)
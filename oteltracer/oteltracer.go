@@ -0,0 +1,73 @@
+// Package oteltracer adapts OpenTelemetry tracing to pananames.Tracer
+//
+//	pnClient, err := pananames.NewClient("token", pananames.WithTracer(oteltracer.New(nil)))
+//
+// Spans are started as children of the span in the context passed with pananames.WithContext
+package oteltracer
+
+import (
+	"context"
+	"fmt"
+
+	pananames "github.com/pananames/go-api-client"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/pananames/go-api-client"
+	spanPrefix          = "pananames."
+)
+
+// Represents pananames.Tracer backed by OpenTelemetry tracer
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// Represents pananames.Span backed by OpenTelemetry span
+type span struct {
+	span trace.Span
+}
+
+// Creates a new tracer from the provider, global provider is used if nil
+func New(provider trace.TracerProvider) *Tracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return &Tracer{tracer: provider.Tracer(instrumentationName)}
+}
+
+// Start a client span named after API operation
+func (t *Tracer) Start(ctx context.Context, operation string) (context.Context, pananames.Span) {
+	ctx, s := t.tracer.Start(ctx, spanPrefix+operation, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &span{span: s}
+}
+
+// Set span attribute converting value to matching attribute type
+func (s *span) SetAttribute(key string, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		s.span.SetAttributes(attribute.String(key, v))
+	case int:
+		s.span.SetAttributes(attribute.Int(key, v))
+	case int64:
+		s.span.SetAttributes(attribute.Int64(key, v))
+	case bool:
+		s.span.SetAttributes(attribute.Bool(key, v))
+	case float64:
+		s.span.SetAttributes(attribute.Float64(key, v))
+	default:
+		s.span.SetAttributes(attribute.String(key, fmt.Sprint(v)))
+	}
+}
+
+// Record error if any and finish the span
+func (s *span) End(err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
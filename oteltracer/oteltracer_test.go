@@ -0,0 +1,46 @@
+package oteltracer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pananames "github.com/pananames/go-api-client"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracerSpans(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/merchant/v2/domains/test.com/renew", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusPaymentRequired)
+		w.Write([]byte(`{"errors":[{"code":402,"message":"Insufficient funds"}]}`))
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client, err := pananames.NewClient("secret", pananames.WithBaseURL(server.URL), pananames.WithTracer(New(provider)))
+	require.NoError(t, err)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	_, err = client.RenewDomain("test.com", &pananames.RenewDomainOptions{Period: "1"}, pananames.WithContext(ctx))
+	require.Error(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	got := spans[0]
+	require.Equal(t, "pananames.RenewDomain", got.Name())
+	require.Equal(t, parent.SpanContext().SpanID(), got.Parent().SpanID())
+	require.Equal(t, codes.Error, got.Status().Code)
+	require.Contains(t, got.Attributes(), attribute.String(pananames.AttrDomain, "test.com"))
+	require.Contains(t, got.Attributes(), attribute.Int(pananames.AttrStatusCode, http.StatusPaymentRequired))
+	require.Contains(t, got.Attributes(), attribute.Int(pananames.AttrAPIErrorCode, 402))
+}
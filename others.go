@@ -1,6 +1,7 @@
 package pananames
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -33,8 +34,8 @@ type TLDNotice struct {
 
 // Represents a domain status info
 type DomainStatus struct {
-	Domain string `json:"domain"`
-	Status string `json:"status"`
+	Domain string      `json:"domain"`
+	Status DomainState `json:"status"`
 }
 
 // Represents an Email info
@@ -43,63 +44,64 @@ type Email struct {
 	FirstEmailDate *PnTime         `json:"first_email_date"`
 	VerifyDate     *PnTime         `json:"verify_date"`
 	SuspendDate    *PnTime         `json:"suspend_date"`
-	Status         string          `json:"status"`
+	Status         EmailStatus     `json:"status"`
 	Domains        []*DomainStatus `json:"domains"`
 }
 
 // Available GetEmails() options
 type GetEmailsOptions struct {
 	ListOptions
-	EmailLike   string `url:"email_like,omitempty"`
-	Status      string `url:"status,omitempty"`
-	EmailStatus string `url:"email_status,omitempty"`
+	EmailLike   string      `url:"email_like,omitempty"`
+	Status      DomainState `url:"status,omitempty"`
+	EmailStatus EmailStatus `url:"email_status,omitempty"`
 }
 
-// Get Registration Notices for all TLDs
-func (c *Client) GetTLDAddReqList(options ...RequestOptionFunc) ([]*TLDNotice, error) {
-	u := "add_req_list"
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
+// Validate GetEmailsOptions filters
+func (opt *GetEmailsOptions) Validate() error {
+	if opt == nil {
+		return nil
 	}
+	var e fieldErrors
+	e.list(opt.ListOptions)
+	return e.err(opt)
+}
 
-	var result []*TLDNotice
-	_, err = c.Do(req, &result)
-	if err != nil {
-		return nil, err
-	}
+// Get Registration Notices for all TLDs
+func (c *Client) GetTLDAddReqList(options ...RequestOptionFunc) ([]*TLDNotice, error) {
+	return c.GetTLDAddReqListCtx(context.Background(), options...)
+}
 
-	return result, nil
+// Same as GetTLDAddReqList() with the request context
+func (c *Client) GetTLDAddReqListCtx(ctx context.Context, options ...RequestOptionFunc) ([]*TLDNotice, error) {
+	u := "add_req_list"
+	options = append([]RequestOptionFunc{operation("GetTLDAddReqList", "")}, options...)
+	return Call[[]*TLDNotice](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Get full list of available TLDs
 func (c *Client) GetTLDs(options ...RequestOptionFunc) ([]*TLD, error) {
-	u := "tlds"
-
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetTLDsCtx(context.Background(), options...)
+}
 
-	var result []*TLD
-	_, err = c.Do(req, &result)
-	if err != nil {
-		return nil, err
-	}
+// Same as GetTLDs() with the request context
+func (c *Client) GetTLDsCtx(ctx context.Context, options ...RequestOptionFunc) ([]*TLD, error) {
+	u := "tlds"
 
-	return result, nil
+	options = append([]RequestOptionFunc{operation("GetTLDs", "")}, options...)
+	return Call[[]*TLD](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Get account related emails
 func (c *Client) GetEmails(opt *GetEmailsOptions, options ...RequestOptionFunc) ([]*Email, *Pagination, error) {
+	return c.GetEmailsCtx(context.Background(), opt, options...)
+}
+
+// Same as GetEmails() with the request context
+func (c *Client) GetEmailsCtx(ctx context.Context, opt *GetEmailsOptions, options ...RequestOptionFunc) ([]*Email, *Pagination, error) {
 	u := "emails"
 
-	req, err := c.NewRequest(http.MethodGet, u, opt, options)
-	if err != nil {
-		return nil, nil, err
-	}
-	var result []*Email
-	resp, err := c.Do(req, &result)
+	options = append([]RequestOptionFunc{operation("GetEmails", "")}, options...)
+	result, resp, err := call[[]*Email](c, ctx, http.MethodGet, u, opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,17 +111,12 @@ func (c *Client) GetEmails(opt *GetEmailsOptions, options ...RequestOptionFunc)
 
 // Get Registration Notices for TLD
 func (c *Client) GetTLDAddReq(tld string, options ...RequestOptionFunc) (*TLDNotice, error) {
-	u := fmt.Sprintf("tlds/%s/add_req", url.PathEscape(tld))
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-
-	result := new(TLDNotice)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetTLDAddReqCtx(context.Background(), tld, options...)
+}
 
-	return result, nil
+// Same as GetTLDAddReq() with the request context
+func (c *Client) GetTLDAddReqCtx(ctx context.Context, tld string, options ...RequestOptionFunc) (*TLDNotice, error) {
+	u := fmt.Sprintf("tlds/%s/add_req", url.PathEscape(tld))
+	options = append([]RequestOptionFunc{operation("GetTLDAddReq", "")}, options...)
+	return Call[*TLDNotice](c, ctx, http.MethodGet, u, nil, options...)
 }
@@ -43,10 +43,10 @@ func TestGetTLDs(t *testing.T) {
 			TLD:                   "XYZ",
 			IDN:                   true,
 			DNSSec:                true,
-			Prices:                &Prices{Currency: "usd", Register: 9.79, Renew: 9.79, Transfer: 9.79, Redeem: 65.79},
-			PromoTwoYearsPrices:   &Prices{Currency: "usd", Register: 2.64},
+			Prices:                usdPrices("9.79", "9.79", "9.79", "65.79"),
+			PromoTwoYearsPrices:   usdPrices("2.64", "0", "0", "0"),
 			PromoTwoYearsUntil:    &PnTime{wantDate},
-			PromoMultiYearsPrices: map[string]*PromoMultiYears{"2": {PromoMultiYearsPrices: &Prices{Currency: "usd", Register: 2.64}, PromoMultiYearsUntil: &PnTime{wantDate}}},
+			PromoMultiYearsPrices: map[string]*PromoMultiYears{"2": {PromoMultiYearsPrices: usdPrices("2.64", "0", "0", "0"), PromoMultiYearsUntil: &PnTime{wantDate}}},
 		},
 	}
 	got, err := client.GetTLDs()
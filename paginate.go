@@ -0,0 +1,313 @@
+package pananames
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+const defaultConcurrency = 4
+
+// Represents a function fetching a single page of a paged list
+type PageFunc[T any] func(ctx context.Context, page ListOptions) ([]T, *Pagination, error)
+
+// Represents a lazy iterator over all items of a paged list
+// Pages are fetched on demand, iteration stops on error or context cancellation
+//
+//	it := client.PaginateDomains(ctx, nil)
+//	for it.Next() {
+//		fmt.Println(it.Value().Domain)
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Paginator[T any] struct {
+	ctx   context.Context
+	fetch PageFunc[T]
+	opt   ListOptions
+	items []T
+	pos   int
+	page  *Pagination
+	value T
+	err   error
+	done  bool
+}
+
+// Creates a new iterator over the list starting from the page in opt, the first page if zero
+func Paginate[T any](ctx context.Context, fetch PageFunc[T], opt ListOptions) *Paginator[T] {
+	if opt.Page < 1 {
+		opt.Page = 1
+	}
+	return &Paginator[T]{ctx: ctx, fetch: fetch, opt: opt}
+}
+
+// Next advances to the next item, fetching the next page if needed
+// Returns false when there are no more items or on error
+func (p *Paginator[T]) Next() bool {
+	if p.err != nil {
+		return false
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+
+	for p.pos >= len(p.items) {
+		if p.done {
+			return false
+		}
+		items, page, err := p.fetch(p.ctx, p.opt)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.items, p.pos, p.page = items, 0, page
+		// Stop on the last page or on an empty page to avoid endless loop
+		if page == nil || page.Page >= page.Pages || len(items) == 0 {
+			p.done = true
+		}
+		p.opt.Page++
+	}
+
+	p.value = p.items[p.pos]
+	p.pos++
+	return true
+}
+
+// Value returns the current item
+func (p *Paginator[T]) Value() T {
+	return p.value
+}
+
+// Err returns the error stopped the iteration, if any
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// Page returns pagination info of the last fetched page
+func (p *Paginator[T]) Page() *Pagination {
+	return p.page
+}
+
+// All collects the remaining items of all pages
+func (p *Paginator[T]) All() ([]T, error) {
+	var all []T
+	for p.Next() {
+		all = append(all, p.Value())
+	}
+	return all, p.Err()
+}
+
+// Iterate over all domains available in your account
+func (c *Client) PaginateDomains(ctx context.Context, opt *GetDomainsOptions, options ...RequestOptionFunc) *Paginator[*Domain] {
+	o := GetDomainsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return Paginate(ctx, func(ctx context.Context, page ListOptions) ([]*Domain, *Pagination, error) {
+		o.ListOptions = page
+		return c.GetDomainsCtx(ctx, &o, options...)
+	}, o.ListOptions)
+}
+
+// Iterate over all active transfers in
+func (c *Client) PaginateTransfersIn(ctx context.Context, opt *GetTransfersInOptions, options ...RequestOptionFunc) *Paginator[*TransferIn] {
+	o := GetTransfersInOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return Paginate(ctx, func(ctx context.Context, page ListOptions) ([]*TransferIn, *Pagination, error) {
+		o.ListOptions = page
+		return c.GetTransfersInCtx(ctx, &o, options...)
+	}, o.ListOptions)
+}
+
+// Iterate over all account related emails
+func (c *Client) PaginateEmails(ctx context.Context, opt *GetEmailsOptions, options ...RequestOptionFunc) *Paginator[*Email] {
+	o := GetEmailsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return Paginate(ctx, func(ctx context.Context, page ListOptions) ([]*Email, *Pagination, error) {
+		o.ListOptions = page
+		return c.GetEmailsCtx(ctx, &o, options...)
+	}, o.ListOptions)
+}
+
+// Iterate over all payments from your account
+func (c *Client) PaginateAccountPayments(ctx context.Context, opt *GetAccountPaymentsOptions, options ...RequestOptionFunc) *Paginator[*Payment] {
+	o := GetAccountPaymentsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return Paginate(ctx, func(ctx context.Context, page ListOptions) ([]*Payment, *Pagination, error) {
+		o.ListOptions = page
+		return c.GetAccountPaymentsCtx(ctx, &o, options...)
+	}, o.ListOptions)
+}
+
+// Represents settings of fetching pages concurrently
+type ParallelOptions struct {
+	// Page size and the first page to fetch
+	ListOptions
+	// Max number of pages fetched at the same time, 4 if zero
+	Concurrency int
+}
+
+// Fetch all pages starting from opt.Page concurrently and merge items in page order
+// The first page is fetched alone to find out the number of pages.
+// Items may shift between pages if the list changes during fetching,
+// items with the same non empty key are kept once. Nil key disables deduplication
+func FetchAllPages[T any](ctx context.Context, fetch PageFunc[T], key func(T) string, opt ParallelOptions) ([]T, error) {
+	if opt.Page < 1 {
+		opt.Page = 1
+	}
+	if opt.Concurrency < 1 {
+		opt.Concurrency = defaultConcurrency
+	}
+
+	first, page, err := fetch(ctx, opt.ListOptions)
+	if err != nil {
+		return nil, err
+	}
+	pages := [][]T{first}
+	if page != nil && page.Pages > opt.Page && len(first) > 0 {
+		rest, err := fetchPages(ctx, fetch, opt, page.Pages)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, rest...)
+	}
+
+	var all []T
+	seen := make(map[string]bool)
+	for _, items := range pages {
+		for _, item := range items {
+			if key != nil {
+				if k := key(item); k != "" {
+					if seen[k] {
+						continue
+					}
+					seen[k] = true
+				}
+			}
+			all = append(all, item)
+		}
+	}
+	return all, nil
+}
+
+// Fetch pages after opt.Page up to last with bounded concurrency
+func fetchPages[T any](ctx context.Context, fetch PageFunc[T], opt ParallelOptions, last int) ([][]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([][]T, last-opt.Page)
+	numbers := make(chan int)
+	errs := make(chan error, opt.Concurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < opt.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := range numbers {
+				items, _, err := fetch(ctx, ListOptions{Limit: opt.Limit, Page: n})
+				if err != nil {
+					errs <- err
+					cancel()
+					return
+				}
+				results[n-opt.Page-1] = items
+			}
+		}()
+	}
+
+feed:
+	for n := opt.Page + 1; n <= last; n++ {
+		select {
+		case numbers <- n:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(numbers)
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Get all domains fetching pages concurrently, concurrency is 4 if zero
+// WithResponseInfo is ignored
+func (c *Client) GetAllDomains(ctx context.Context, opt *GetDomainsOptions, concurrency int, options ...RequestOptionFunc) ([]*Domain, error) {
+	options = withoutResponseInfo(options)
+	o := GetDomainsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return FetchAllPages(ctx, func(ctx context.Context, page ListOptions) ([]*Domain, *Pagination, error) {
+		o := o
+		o.ListOptions = page
+		return c.GetDomainsCtx(ctx, &o, options...)
+	}, func(d *Domain) string {
+		return strings.ToLower(d.Domain)
+	}, ParallelOptions{ListOptions: o.ListOptions, Concurrency: concurrency})
+}
+
+// Get all active transfers in fetching pages concurrently, concurrency is 4 if zero
+// WithResponseInfo is ignored
+func (c *Client) GetAllTransfersIn(ctx context.Context, opt *GetTransfersInOptions, concurrency int, options ...RequestOptionFunc) ([]*TransferIn, error) {
+	options = withoutResponseInfo(options)
+	o := GetTransfersInOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return FetchAllPages(ctx, func(ctx context.Context, page ListOptions) ([]*TransferIn, *Pagination, error) {
+		o := o
+		o.ListOptions = page
+		return c.GetTransfersInCtx(ctx, &o, options...)
+	}, func(t *TransferIn) string {
+		return strings.ToLower(t.Domain)
+	}, ParallelOptions{ListOptions: o.ListOptions, Concurrency: concurrency})
+}
+
+// Get all account related emails fetching pages concurrently, concurrency is 4 if zero
+// WithResponseInfo is ignored
+func (c *Client) GetAllEmails(ctx context.Context, opt *GetEmailsOptions, concurrency int, options ...RequestOptionFunc) ([]*Email, error) {
+	options = withoutResponseInfo(options)
+	o := GetEmailsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return FetchAllPages(ctx, func(ctx context.Context, page ListOptions) ([]*Email, *Pagination, error) {
+		o := o
+		o.ListOptions = page
+		return c.GetEmailsCtx(ctx, &o, options...)
+	}, func(e *Email) string {
+		return strings.ToLower(e.Email)
+	}, ParallelOptions{ListOptions: o.ListOptions, Concurrency: concurrency})
+}
+
+// Get all payments from your account fetching pages concurrently, concurrency is 4 if zero
+// WithResponseInfo is ignored
+func (c *Client) GetAllAccountPayments(ctx context.Context, opt *GetAccountPaymentsOptions, concurrency int, options ...RequestOptionFunc) ([]*Payment, error) {
+	options = withoutResponseInfo(options)
+	o := GetAccountPaymentsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return FetchAllPages(ctx, func(ctx context.Context, page ListOptions) ([]*Payment, *Pagination, error) {
+		o := o
+		o.ListOptions = page
+		return c.GetAccountPaymentsCtx(ctx, &o, options...)
+	}, func(p *Payment) string {
+		return p.TxID
+	}, ParallelOptions{ListOptions: o.ListOptions, Concurrency: concurrency})
+}
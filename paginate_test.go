@@ -0,0 +1,171 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// serves paged domains list with the given number of pages, 2 domains per page
+func handleDomainPages(t *testing.T, mux *http.ServeMux, pages int, requested *[]int) {
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("current_page"))
+		if requested != nil {
+			*requested = append(*requested, page)
+		}
+		fmt.Fprintf(w, `{"meta":{"current_page":%d,"per_page":2,"total_entries":%d,"total_pages":%d},"data":[{"domain":"d%d-1.com"},{"domain":"d%d-2.com"}]}`,
+			page, pages*2, pages, page, page)
+	})
+}
+
+func TestPaginateDomains(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var requested []int
+	handleDomainPages(t, mux, 3, &requested)
+
+	opt := &GetDomainsOptions{Status: "ok"}
+	it := client.PaginateDomains(context.Background(), opt)
+	var got []string
+	for it.Next() {
+		got = append(got, it.Value().Domain)
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []string{"d1-1.com", "d1-2.com", "d2-1.com", "d2-2.com", "d3-1.com", "d3-2.com"}, got)
+	require.Equal(t, []int{1, 2, 3}, requested)
+	require.Equal(t, &Pagination{Total: 6, Limit: 2, Page: 3, Pages: 3}, it.Page())
+	// options passed by caller are not modified
+	require.Equal(t, &GetDomainsOptions{Status: "ok"}, opt)
+}
+
+func TestPaginateAll(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var requested []int
+	handleDomainPages(t, mux, 3, &requested)
+
+	opt := &GetDomainsOptions{ListOptions: ListOptions{Page: 2}}
+	got, err := client.PaginateDomains(context.Background(), opt).All()
+	require.NoError(t, err)
+	require.Len(t, got, 4)
+	require.Equal(t, []int{2, 3}, requested)
+}
+
+func TestPaginateError(t *testing.T) {
+	calls := 0
+	fetch := func(ctx context.Context, page ListOptions) ([]int, *Pagination, error) {
+		calls++
+		if page.Page == 2 {
+			return nil, nil, errors.New("failed")
+		}
+		return []int{page.Page}, &Pagination{Page: page.Page, Pages: 5}, nil
+	}
+
+	got, err := Paginate(context.Background(), fetch, ListOptions{}).All()
+	require.EqualError(t, err, "failed")
+	require.Equal(t, []int{1}, got)
+	require.Equal(t, 2, calls)
+}
+
+func TestPaginateContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fetch := func(ctx context.Context, page ListOptions) ([]int, *Pagination, error) {
+		return []int{1, 2}, &Pagination{Page: page.Page, Pages: 100}, nil
+	}
+
+	it := Paginate(ctx, fetch, ListOptions{})
+	require.True(t, it.Next())
+	cancel()
+	require.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), context.Canceled)
+}
+
+func TestPaginateEmptyPage(t *testing.T) {
+	calls := 0
+	fetch := func(ctx context.Context, page ListOptions) ([]int, *Pagination, error) {
+		calls++
+		return nil, &Pagination{Page: page.Page, Pages: 10}, nil
+	}
+
+	got, err := Paginate(context.Background(), fetch, ListOptions{}).All()
+	require.NoError(t, err)
+	require.Empty(t, got)
+	require.Equal(t, 1, calls)
+}
+
+func TestGetAllDomains(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var mu sync.Mutex
+	var requested []int
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "suspended", r.URL.Query().Get("status"))
+		require.Equal(t, "2", r.URL.Query().Get("per_page"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("current_page"))
+		mu.Lock()
+		requested = append(requested, page)
+		mu.Unlock()
+		// the list shifted: the last domain of page 1 is repeated on page 2
+		first := page*2 - 1
+		if page > 1 {
+			first--
+		}
+		fmt.Fprintf(w, `{"meta":{"current_page":%d,"per_page":2,"total_entries":10,"total_pages":5},"data":[{"domain":"d%d.com"},{"domain":"D%d.com"}]}`,
+			page, first, first+1)
+	})
+
+	// pages are fetched concurrently, response info is ignored
+	info := &ResponseInfo{}
+	got, err := client.GetAllDomains(context.Background(), &GetDomainsOptions{ListOptions: ListOptions{Limit: 2}, Status: "suspended"}, 2, WithResponseInfo(info))
+	require.NoError(t, err)
+	require.Equal(t, &ResponseInfo{}, info)
+	var names []string
+	for _, d := range got {
+		names = append(names, strings.ToLower(d.Domain))
+	}
+	require.Equal(t, []string{"d1.com", "d2.com", "d3.com", "d4.com", "d5.com", "d6.com", "d7.com", "d8.com", "d9.com"}, names)
+	sort.Ints(requested)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, requested)
+}
+
+func TestFetchAllPagesError(t *testing.T) {
+	var calls int32
+	fetch := func(ctx context.Context, page ListOptions) ([]int, *Pagination, error) {
+		atomic.AddInt32(&calls, 1)
+		if page.Page == 3 {
+			return nil, nil, errors.New("failed")
+		}
+		if page.Page > 3 {
+			<-ctx.Done()
+			return nil, nil, ctx.Err()
+		}
+		return []int{page.Page}, &Pagination{Page: page.Page, Pages: 100}, nil
+	}
+
+	_, err := FetchAllPages(context.Background(), fetch, nil, ParallelOptions{Concurrency: 3})
+	require.EqualError(t, err, "failed")
+	require.Less(t, atomic.LoadInt32(&calls), int32(100))
+}
+
+func TestFetchAllPagesSinglePage(t *testing.T) {
+	fetch := func(ctx context.Context, page ListOptions) ([]int, *Pagination, error) {
+		require.Equal(t, 1, page.Page)
+		return []int{1, 1, 2}, &Pagination{Page: 1, Pages: 1}, nil
+	}
+
+	got, err := FetchAllPages(context.Background(), fetch, nil, ParallelOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 1, 2}, got)
+}
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -35,8 +38,23 @@ type PnDate struct {
 type Client struct {
 	httpClient *http.Client
 	baseURL    *url.URL
-	token      string
 	userAgent  string
+
+	tokenMu       sync.RWMutex
+	tokenProvider TokenProvider
+
+	retryPolicy  *RetryPolicy
+	limiter      *RateLimiter
+	writeLimiter *RateLimiter
+	middlewares  []Middleware
+	logger       *slog.Logger
+	logConfig    LogConfig
+	tracer       Tracer
+	metrics      MetricsCollector
+	noValidation bool
+	idn          bool
+	tldCatalog   *TLDCatalog
+	balanceGuard *BalanceGuard
 }
 
 // Represents api response
@@ -62,11 +80,19 @@ type Pagination struct {
 // Represents error api response with Response struct
 type ErrorResponse struct {
 	Response *http.Response
-	Errors   []struct {
-		Code        int    `json:"code"`
-		Message     string `json:"message"`
-		Description string `json:"description"`
-	} `json:"errors"`
+	Errors   []APIError `json:"errors"`
+}
+
+// Represents a single error from api response
+type APIError struct {
+	Code        int    `json:"code"`
+	Message     string `json:"message"`
+	Description string `json:"description"`
+
+	// HTTP status code of the response
+	status int
+	// API operation name of the request
+	operation string
 }
 
 // Represents pagination options
@@ -81,6 +107,18 @@ type Option func(*Client) error
 // Represents option func to customize API request
 type RequestOptionFunc func(*http.Request) error
 
+type contextKey int
+
+const requestConfigKey contextKey = iota
+
+// Represents internal settings of API request carried in its context
+type requestConfig struct {
+	operation     string
+	domain        string
+	nonIdempotent bool
+	info          *ResponseInfo
+}
+
 // Custom Unmarshall for PnTime
 func (t *PnTime) UnmarshalJSON(data []byte) error {
 	if string(data) == `""` {
@@ -134,11 +172,48 @@ func (p *Pagination) PreviousPage() int {
 // Run API request with provided context
 func WithContext(ctx context.Context) RequestOptionFunc {
 	return func(req *http.Request) error {
-		*req = *req.WithContext(ctx)
+		*req = *withRequestContext(req, ctx)
+		return nil
+	}
+}
+
+// Shallow copy of the request with the new context, internal request settings are kept
+func withRequestContext(req *http.Request, ctx context.Context) *http.Request {
+	if cfg := getRequestConfig(req); cfg != nil {
+		ctx = context.WithValue(ctx, requestConfigKey, cfg)
+	}
+	return req.WithContext(ctx)
+}
+
+// Set API operation name and domain of the request, used by tracing and metrics
+func operation(name, domain string) RequestOptionFunc {
+	return func(req *http.Request) error {
+		if cfg := getRequestConfig(req); cfg != nil {
+			cfg.operation = name
+			cfg.domain = domain
+		}
 		return nil
 	}
 }
 
+// Operation name of requests not made by client methods, e.g. raw Call()
+// The path isn't used as it contains domain names and would make metric labels unbounded
+const unknownOperation = "unknown"
+
+// Get API operation name of the request
+func operationName(req *http.Request) string {
+	if cfg := getRequestConfig(req); cfg != nil && cfg.operation != "" {
+		return cfg.operation
+	}
+	return unknownOperation
+}
+
+// Get internal settings of the request, nil if request wasn't created by NewRequest
+func getRequestConfig(req *http.Request) *requestConfig {
+	cfg, _ := req.Context().Value(requestConfigKey).(*requestConfig)
+	return cfg
+}
+
 // WithBaseURL Set BaseURL for api client
 func WithBaseURL(baseURL string) Option {
 	return func(c *Client) error {
@@ -188,9 +263,9 @@ func (c *Client) parseOptions(opts ...Option) error {
 // Creates a new instance of api client
 func NewClient(token string, opts ...Option) (*Client, error) {
 	c := &Client{
-		userAgent:  userAgent,
-		token:      token,
-		httpClient: &http.Client{Timeout: time.Second * 30},
+		userAgent:     userAgent,
+		tokenProvider: StaticToken(token),
+		httpClient:    &http.Client{Timeout: time.Second * 30},
 	}
 	_ = c.setBaseURL(baseURL)
 	if err := c.parseOptions(opts...); err != nil {
@@ -200,29 +275,36 @@ func NewClient(token string, opts ...Option) (*Client, error) {
 }
 
 // Make an http request, check and parse response
+// Transient failures of idempotent requests are retried according to the retry policy
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doer().Do(req)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
 	if err != nil {
+		if resp != nil {
+			setResponseInfo(req, resp, nil)
+		}
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, err
+	if resp == nil {
+		return nil, errors.New("no response returned")
 	}
+	setResponseInfo(req, resp, nil)
 
 	// Parse data field from response
 	if v != nil {
 		result := &Response{}
 		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return nil, fmt.Errorf("status: %d, unable to decode response, unknown format: %v", resp.StatusCode, err)
+			return nil, &DecodeError{StatusCode: resp.StatusCode, Reason: "unable to decode response, unknown format", Err: err}
 		}
+		setResponseInfo(req, resp, result)
 		// Decode the data field
 		if result.Data == nil {
-			return nil, fmt.Errorf("status: %d, missing data from response", resp.StatusCode)
+			return nil, &DecodeError{StatusCode: resp.StatusCode, Reason: "missing data from response"}
 		}
 		if err := json.Unmarshal(result.Data, v); err != nil {
-			return result, fmt.Errorf("status: %d, unable to parse response data: %s", resp.StatusCode, err)
+			return result, &DecodeError{StatusCode: resp.StatusCode, Reason: "unable to parse response data", Err: err}
 		}
 		fixZeroDate(v)
 		return result, nil
@@ -231,19 +313,73 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 	return nil, err
 }
 
+// Send request and check response, retry transient failures
+// Every attempt waits for the rate limiter first
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	r := req
+	for attempt := 0; ; attempt++ {
+		if err := c.waitRateLimit(r); err != nil {
+			return nil, err
+		}
+		resp, err := c.httpClient.Do(r)
+		if err != nil {
+			err = &transportError{req: r, err: err}
+		} else {
+			err = CheckResponse(resp)
+		}
+		if err == nil || c.retryPolicy == nil || attempt >= c.retryPolicy.MaxRetries || !c.retryPolicy.retryable(r, resp, err) {
+			return resp, err
+		}
+
+		delay, ok := c.retryPolicy.backoff(attempt, resp)
+		if !ok {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return nil, err
+		}
+		if r, err = rewindRequest(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
 // Creates and validates a new request
 // sets required headers
 func (c *Client) NewRequest(method, path string, opt interface{}, options []RequestOptionFunc) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, path, opt, options)
+}
+
+// Same as NewRequest() with the request context
+func (c *Client) NewRequestWithContext(ctx context.Context, method, path string, opt interface{}, options []RequestOptionFunc) (*http.Request, error) {
+	if ctx == nil {
+		return nil, errors.New("nil context")
+	}
+	path, err := c.asciiPath(path)
+	if err != nil {
+		return nil, err
+	}
 	u := *c.baseURL
 	u.Path = c.baseURL.Path + path
 
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Prepare headers
 	reqHeaders := make(http.Header)
 	reqHeaders.Set("Accept", "application/json")
-	reqHeaders.Set("SIGNATURE", c.token)
+	reqHeaders.Set("SIGNATURE", token)
 	reqHeaders.Set("User-Agent", c.userAgent)
 
 	// Validate and marshall request body if any
+	if err := c.validate(opt); err != nil {
+		return nil, err
+	}
 	var body []byte
 	if method == http.MethodPost || method == http.MethodPut || method == http.MethodDelete {
 		reqHeaders.Set("Content-Type", "application/json")
@@ -263,7 +399,8 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 		}
 	}
 	// Create a new request
-	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	ctx = context.WithValue(ctx, requestConfigKey, &requestConfig{})
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -300,18 +437,23 @@ func CheckResponse(r *http.Response) error {
 	if data != nil {
 		errorResponse := &ErrorResponse{Response: r}
 		if err := json.Unmarshal(data, errorResponse); err != nil {
-			return fmt.Errorf("status: %d, can't parse error, unknown format, raw data: %s", r.StatusCode, data)
+			return &StatusError{Response: r, Body: data}
+		}
+		operation := responseOperation(r)
+		for i := range errorResponse.Errors {
+			errorResponse.Errors[i].status = r.StatusCode
+			errorResponse.Errors[i].operation = operation
 		}
 		return errorResponse
 	}
-	return fmt.Errorf("status: %d, empty response", r.StatusCode)
+	return &StatusError{Response: r}
 }
 
 // Parse value, find zero *PnTime and set it to nil to avoid misleading
 func fixZeroDate(value interface{}) {
 	v := reflect.ValueOf(value)
 
-	if v.Kind() == reflect.Ptr {
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
 		v = v.Elem()
 	}
 
@@ -326,6 +468,9 @@ func fixZeroDate(value interface{}) {
 		}
 	case reflect.Struct:
 		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
 			zeroPnTime(v.Field(i))
 		}
 	}
@@ -2,6 +2,7 @@ package pananames
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -135,3 +136,36 @@ func TestWithUserAgent(t *testing.T) {
 
 	assert.Equal(t, "Custom", c.userAgent)
 }
+
+type testCtxKey struct{}
+
+func TestCtxVariant(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "domain.json")
+	})
+
+	_, err := client.GetDomainCtx(context.Background(), "test.com")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.GetDomainCtx(ctx, "test.com")
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestNewRequestWithContext(t *testing.T) {
+	c, err := NewClient("secret")
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), testCtxKey{}, "value")
+	req, err := c.NewRequestWithContext(ctx, http.MethodGet, "domains", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "value", req.Context().Value(testCtxKey{}))
+	require.NotNil(t, getRequestConfig(req))
+
+	_, err = c.NewRequestWithContext(nil, http.MethodGet, "domains", nil, nil)
+	require.Error(t, err)
+}
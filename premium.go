@@ -0,0 +1,80 @@
+package pananames
+
+import (
+	"context"
+	"fmt"
+)
+
+// Represents a premium price above the approved maximum, it matches ErrPremiumPriceTooHigh
+type PremiumPriceError struct {
+	Domain    string
+	Operation QuoteOperation
+	Price     Money
+	Max       Money
+}
+
+func (e *PremiumPriceError) Error() string {
+	return fmt.Sprintf("premium %s price of %s is %s, above the maximum %s", e.Operation, e.Domain, e.Price, e.Max)
+}
+
+func (e *PremiumPriceError) Unwrap() error {
+	return ErrPremiumPriceTooHigh
+}
+
+// FillRegisterPremiumPrice Check the domain and set PremiumPrice of the options
+// PremiumPrice is set to the register price of premium domains and cleared for regular ones.
+// Returns *PremiumPriceError if the premium price is above max, a zero max refuses all premium domains.
+// Returns ErrDomainNotAvailable if the domain can't be registered
+func (c *Client) FillRegisterPremiumPrice(ctx context.Context, opt *RegisterDomainOptions, max Money, options ...RequestOptionFunc) (*DomainCheck, error) {
+	if opt == nil || opt.Domain == "" {
+		return nil, fmt.Errorf("%T domain can't be empty", opt)
+	}
+	check, price, err := c.premiumPrice(ctx, opt.Domain, QuoteRegister, max, options...)
+	if err != nil {
+		return check, err
+	}
+	if !check.Available {
+		return check, fmt.Errorf("domain %s is not available: %w", opt.Domain, ErrDomainNotAvailable)
+	}
+	opt.PremiumPrice = price
+	return check, nil
+}
+
+// FillTransferPremiumPrice Check the domain and set PremiumPrice of the options
+// PremiumPrice is set to the transfer price of premium domains and cleared for regular ones.
+// Returns *PremiumPriceError if the premium price is above max, a zero max refuses all premium domains
+func (c *Client) FillTransferPremiumPrice(ctx context.Context, opt *InitTransferInOptions, max Money, options ...RequestOptionFunc) (*DomainCheck, error) {
+	if opt == nil || opt.Domain == "" {
+		return nil, fmt.Errorf("%T domain can't be empty", opt)
+	}
+	check, price, err := c.premiumPrice(ctx, opt.Domain, QuoteTransfer, max, options...)
+	if err != nil {
+		return check, err
+	}
+	opt.PremiumPrice = price
+	return check, nil
+}
+
+// Check the domain and get its premium price of the operation, nil for regular domains
+func (c *Client) premiumPrice(ctx context.Context, domain string, op QuoteOperation, max Money, options ...RequestOptionFunc) (*DomainCheck, *Money, error) {
+	check, err := c.CheckDomainCtx(ctx, domain, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !check.Premium {
+		return check, nil, nil
+	}
+
+	price, ok := operationPrice(check.Prices, op)
+	if !ok {
+		return check, nil, fmt.Errorf("no premium %s price for domain %s", op, domain)
+	}
+	cmp, err := price.Cmp(max)
+	if err != nil {
+		return check, nil, err
+	}
+	if cmp > 0 {
+		return check, nil, &PremiumPriceError{Domain: domain, Operation: op, Price: price, Max: max}
+	}
+	return check, &price, nil
+}
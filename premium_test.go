@@ -0,0 +1,86 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFillPremiumPrice(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	checks := map[string]string{
+		"vip.com":     `{"domain":"vip.com","available":true,"premium":true,"prices":{"currency":"usd","register":250.5,"transfer":120}}`,
+		"regular.com": `{"domain":"regular.com","available":true,"prices":{"currency":"usd","register":9.79,"transfer":9.79}}`,
+		"taken.com":   `{"domain":"taken.com","available":false,"prices":{"currency":"usd","register":9.79,"transfer":9.79}}`,
+	}
+	for domain, check := range checks {
+		check := check
+		mux.HandleFunc(apiVerPath+"domains/"+domain+"/check", func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodGet, r.Method)
+			fmt.Fprintf(w, `{"data":%s}`, check)
+		})
+	}
+	ctx := context.Background()
+	max := MustParseMoney("300", "usd")
+
+	opts := newRegisterOptions("vip.com")
+	check, err := client.FillRegisterPremiumPrice(ctx, opts, max)
+	require.NoError(t, err)
+	require.True(t, check.Premium)
+	require.Equal(t, MustParseMoney("250.5", "usd"), *opts.PremiumPrice)
+
+	// stale price of a regular domain is cleared
+	opts = newRegisterOptions("regular.com")
+	opts.PremiumPrice = &max
+	_, err = client.FillRegisterPremiumPrice(ctx, opts, max)
+	require.NoError(t, err)
+	require.Nil(t, opts.PremiumPrice)
+
+	opts = newRegisterOptions("vip.com")
+	_, err = client.FillRegisterPremiumPrice(ctx, opts, MustParseMoney("250", "usd"))
+	require.ErrorIs(t, err, ErrPremiumPriceTooHigh)
+	var priceErr *PremiumPriceError
+	require.True(t, errors.As(err, &priceErr))
+	require.Equal(t, &PremiumPriceError{
+		Domain:    "vip.com",
+		Operation: QuoteRegister,
+		Price:     MustParseMoney("250.5", "usd"),
+		Max:       MustParseMoney("250", "usd"),
+	}, priceErr)
+	require.EqualError(t, err, "premium register price of vip.com is 250.5 usd, above the maximum 250 usd")
+	require.Nil(t, opts.PremiumPrice)
+
+	// zero maximum refuses all premium domains
+	_, err = client.FillRegisterPremiumPrice(ctx, opts, Money{})
+	require.ErrorIs(t, err, ErrPremiumPriceTooHigh)
+
+	_, err = client.FillRegisterPremiumPrice(ctx, opts, MustParseMoney("1000", "eur"))
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = client.FillRegisterPremiumPrice(ctx, newRegisterOptions("taken.com"), max)
+	require.ErrorIs(t, err, ErrDomainNotAvailable)
+
+	_, err = client.FillRegisterPremiumPrice(ctx, nil, max)
+	require.Error(t, err)
+
+	transfer := &InitTransferInOptions{Domain: "vip.com", AuthCode: "123"}
+	_, err = client.FillTransferPremiumPrice(ctx, transfer, max)
+	require.NoError(t, err)
+	require.Equal(t, MustParseMoney("120", "usd"), *transfer.PremiumPrice)
+
+	transfer = &InitTransferInOptions{Domain: "vip.com", AuthCode: "123"}
+	_, err = client.FillTransferPremiumPrice(ctx, transfer, MustParseMoney("100", ""))
+	require.ErrorIs(t, err, ErrPremiumPriceTooHigh)
+	require.Nil(t, transfer.PremiumPrice)
+
+	transfer = &InitTransferInOptions{Domain: "taken.com", AuthCode: "123"}
+	_, err = client.FillTransferPremiumPrice(ctx, transfer, max)
+	require.NoError(t, err)
+	require.Nil(t, transfer.PremiumPrice)
+}
@@ -0,0 +1,279 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Represents a charged operation on a domain
+type QuoteOperation string
+
+const (
+	QuoteRegister QuoteOperation = "register"
+	QuoteRenew    QuoteOperation = "renew"
+	QuoteTransfer QuoteOperation = "transfer"
+	QuoteRedeem   QuoteOperation = "redeem"
+)
+
+// Represents a promo applied to the quote
+type PromoKind string
+
+const (
+	// Promo price for the first year, TLD.PromoPrices
+	PromoKindFirstYear PromoKind = "promo"
+	// Promo price for every year of 2 years period, TLD.PromoTwoYearsPrices
+	PromoKindTwoYears PromoKind = "two_years"
+	// Promo price for every year of the period, TLD.PromoMultiYearsPrices
+	PromoKindMultiYears PromoKind = "multi_years"
+)
+
+// Available options for Quote()
+type QuoteOptions struct {
+	Domains   []string
+	Operation QuoteOperation
+	// Period in years for register and renew, transfer and redeem are charged once
+	Period int
+	// Time the promo expiry dates are checked at, now if zero
+	At time.Time
+}
+
+// Represents the expected charge for the single domain
+type QuoteItem struct {
+	Domain    string
+	Operation QuoteOperation
+	Period    int
+	Premium   bool
+	// Applied promo, empty if regular prices are charged
+	Promo      PromoKind
+	PromoUntil *PnTime
+	// Charge of every year of the period, single charge for transfer and redeem
+	Charges []Money
+	Total   Money
+	// Reason the domain can't be quoted, the item is not included in the quote total
+	Err error
+}
+
+// Represents the expected charge for the domains
+type Quote struct {
+	Items []*QuoteItem
+	// Sum of items without errors
+	Total Money
+	At    time.Time
+}
+
+// Valid reports whether the operation is known
+func (o QuoteOperation) Valid() bool {
+	switch o {
+	case QuoteRegister, QuoteRenew, QuoteTransfer, QuoteRedeem:
+		return true
+	}
+	return false
+}
+
+// Validate QuoteOptions
+func (opt *QuoteOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	if len(opt.Domains) == 0 {
+		e.add("domains", "is required")
+	}
+	for i, d := range opt.Domains {
+		e.domain(fmt.Sprintf("domains[%d]", i), d)
+	}
+	switch opt.Operation {
+	case "":
+		e.add("operation", "is required")
+	case QuoteRegister, QuoteRenew:
+		e.period("period", opt.Period)
+	case QuoteTransfer, QuoteRedeem:
+		if opt.Period > 1 {
+			e.add("period", "must be 1 for %s, got %d", opt.Operation, opt.Period)
+		}
+	default:
+		e.enum("operation", string(opt.Operation), false)
+	}
+	return e.err(opt)
+}
+
+// Quote Compute the expected charge for the operation on the domains
+func (c *Client) Quote(opt *QuoteOptions, options ...RequestOptionFunc) (*Quote, error) {
+	return c.QuoteCtx(context.Background(), opt, options...)
+}
+
+// Same as Quote() with the request context
+// Prices are taken from CheckDomainsBulk(), promos and their expiry dates from the TLD catalog
+// set by WithTLDCatalog() or fetched with GetTLDCatalog(). Promos don't apply to premium domains.
+// Domains which can't be quoted are reported in QuoteItem.Err and joined into the returned error
+func (c *Client) QuoteCtx(ctx context.Context, opt *QuoteOptions, options ...RequestOptionFunc) (*Quote, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+	catalog := c.tldCatalog
+	if catalog == nil {
+		var err error
+		if catalog, err = c.GetTLDCatalog(ctx, options...); err != nil {
+			return nil, err
+		}
+	}
+	checks, err := c.CheckDomainsBulkCtx(ctx, &CheckDomainsBulkOptions{Domains: opt.Domains}, options...)
+	if err != nil {
+		return nil, err
+	}
+	byDomain := make(map[string]*DomainCheck, len(checks))
+	for _, check := range checks {
+		byDomain[strings.ToLower(check.Domain)] = check
+	}
+
+	quote := &Quote{At: opt.At}
+	if quote.At.IsZero() {
+		quote.At = time.Now()
+	}
+	var errs []error
+	for _, domain := range opt.Domains {
+		item := &QuoteItem{Domain: domain, Operation: opt.Operation, Period: opt.Period}
+		if key, err := normalizeName(domain); err != nil {
+			item.Err = err
+		} else if check, ok := byDomain[key]; !ok {
+			item.Err = fmt.Errorf("domain %s is missing in check results: %w", domain, ErrNotFound)
+		} else if name, err := catalog.Parse(key); err != nil {
+			item.Err = err
+		} else {
+			tld, _ := catalog.Lookup(name.TLD)
+			item, _ = QuoteDomain(check, tld, opt.Operation, opt.Period, quote.At)
+			item.Domain = domain
+		}
+
+		if item.Err == nil {
+			item.Err = quote.add(item)
+		}
+		if item.Err != nil {
+			errs = append(errs, fmt.Errorf("quote %s: %w", domain, item.Err))
+		}
+		quote.Items = append(quote.Items, item)
+	}
+	return quote, errors.Join(errs...)
+}
+
+// QuoteDomain Compute the expected charge for the operation on the checked domain
+// Register and renew are charged for every year of the period, the best active promo of the TLD is applied:
+// multi years promo for the period, two years promo for 2 years period, then first year promo.
+// Transfer and redeem are charged once, only the first year promo applies to them.
+// Promo prices equal to zero mean no promo for the operation. tld may be nil, then regular prices are charged
+func QuoteDomain(check *DomainCheck, tld *TLD, op QuoteOperation, period int, at time.Time) (*QuoteItem, error) {
+	item := &QuoteItem{Domain: check.Domain, Operation: op, Period: period, Premium: check.Premium}
+	item.Err = item.compute(check, tld, at)
+	return item, item.Err
+}
+
+// Compute charges of the item
+func (item *QuoteItem) compute(check *DomainCheck, tld *TLD, at time.Time) error {
+	if !item.Operation.Valid() {
+		return fmt.Errorf("unknown operation %q: %w", item.Operation, ErrValidation)
+	}
+	if item.Operation == QuoteRegister && !check.Available {
+		return fmt.Errorf("domain %s is not available: %w", check.Domain, ErrDomainNotAvailable)
+	}
+	regular, ok := operationPrice(check.Prices, item.Operation)
+	if !ok {
+		return fmt.Errorf("no %s price for domain %s", item.Operation, check.Domain)
+	}
+
+	years := item.Period
+	if item.Operation == QuoteTransfer || item.Operation == QuoteRedeem || years < 1 {
+		years = 1
+	}
+	item.Charges = make([]Money, years)
+	for i := range item.Charges {
+		item.Charges[i] = regular
+	}
+
+	if tld != nil && !item.Premium {
+		item.applyPromo(tld, at)
+	}
+
+	item.Total = Money{Currency: regular.Currency}
+	for _, charge := range item.Charges {
+		total, err := item.Total.Add(charge)
+		if err != nil {
+			return err
+		}
+		item.Total = total
+	}
+	return nil
+}
+
+// Replace regular charges with the best active promo of the TLD
+func (item *QuoteItem) applyPromo(tld *TLD, at time.Time) {
+	years := len(item.Charges)
+	if item.Operation == QuoteRegister || item.Operation == QuoteRenew {
+		if multi, ok := tld.PromoMultiYearsPrices[strconv.Itoa(years)]; ok && multi != nil && promoActive(multi.PromoMultiYearsUntil, at) {
+			if price, ok := operationPrice(multi.PromoMultiYearsPrices, item.Operation); ok {
+				item.setPromo(PromoKindMultiYears, multi.PromoMultiYearsUntil, price, years)
+				return
+			}
+		}
+		if years == 2 && promoActive(tld.PromoTwoYearsUntil, at) {
+			if price, ok := operationPrice(tld.PromoTwoYearsPrices, item.Operation); ok {
+				item.setPromo(PromoKindTwoYears, tld.PromoTwoYearsUntil, price, years)
+				return
+			}
+		}
+	}
+	if promoActive(tld.PromoUntil, at) {
+		if price, ok := operationPrice(tld.PromoPrices, item.Operation); ok {
+			item.setPromo(PromoKindFirstYear, tld.PromoUntil, price, 1)
+		}
+	}
+}
+
+// Charge the promo price for the first n years
+func (item *QuoteItem) setPromo(kind PromoKind, until *PnTime, price Money, n int) {
+	item.Promo = kind
+	item.PromoUntil = until
+	for i := 0; i < n; i++ {
+		item.Charges[i] = price
+	}
+}
+
+// Add the item to the quote total
+func (q *Quote) add(item *QuoteItem) error {
+	total, err := q.Total.Add(item.Total)
+	if err != nil {
+		return err
+	}
+	q.Total = total
+	return nil
+}
+
+// Price of the operation, false if there are no prices or the price is zero
+func operationPrice(p *Prices, op QuoteOperation) (Money, bool) {
+	if p == nil {
+		return Money{}, false
+	}
+	var price Money
+	switch op {
+	case QuoteRegister:
+		price = p.Register
+	case QuoteRenew:
+		price = p.Renew
+	case QuoteTransfer:
+		price = p.Transfer
+	case QuoteRedeem:
+		price = p.Redeem
+	}
+	if price.Currency == "" {
+		price.Currency = p.Currency
+	}
+	return price, !price.IsZero()
+}
+
+// Check the promo with the expiry date is active at the time
+func promoActive(until *PnTime, at time.Time) bool {
+	return until != nil && at.Before(until.Time)
+}
@@ -0,0 +1,137 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteDomain(t *testing.T) {
+	until := &PnTime{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}
+	before := until.Add(-time.Hour)
+	after := until.Add(time.Hour)
+	tld := &TLD{
+		TLD:                 "xyz",
+		Prices:              usdPrices("10", "12", "8", "50"),
+		PromoPrices:         usdPrices("1.99", "0", "5", "0"),
+		PromoUntil:          until,
+		PromoTwoYearsPrices: usdPrices("3.5", "0", "0", "0"),
+		PromoTwoYearsUntil:  until,
+		PromoMultiYearsPrices: map[string]*PromoMultiYears{
+			"3": {PromoMultiYearsPrices: usdPrices("4", "11", "0", "0"), PromoMultiYearsUntil: until},
+		},
+	}
+	check := &DomainCheck{Domain: "test.xyz", Available: true, Prices: usdPrices("10", "12", "8", "50")}
+
+	tests := []struct {
+		name   string
+		op     QuoteOperation
+		period int
+		at     time.Time
+		promo  PromoKind
+		total  string
+	}{
+		{"first year promo", QuoteRegister, 1, before, PromoKindFirstYear, "1.99"},
+		{"first year promo and regular years", QuoteRegister, 4, before, PromoKindFirstYear, "31.99"},
+		{"two years promo", QuoteRegister, 2, before, PromoKindTwoYears, "7"},
+		{"multi years promo", QuoteRegister, 3, before, PromoKindMultiYears, "12"},
+		{"multi years renew promo", QuoteRenew, 3, before, PromoKindMultiYears, "33"},
+		{"zero promo price", QuoteRenew, 2, before, "", "24"},
+		{"transfer promo", QuoteTransfer, 1, before, PromoKindFirstYear, "5"},
+		{"redeem", QuoteRedeem, 0, before, "", "50"},
+		{"expired promo", QuoteRegister, 2, after, "", "20"},
+	}
+	for _, tt := range tests {
+		item, err := QuoteDomain(check, tld, tt.op, tt.period, tt.at)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.promo, item.Promo, tt.name)
+		require.Equal(t, MustParseMoney(tt.total, "usd"), item.Total, tt.name)
+		if tt.promo != "" {
+			require.Equal(t, until, item.PromoUntil, tt.name)
+		}
+	}
+
+	item, err := QuoteDomain(check, tld, QuoteRegister, 4, before)
+	require.NoError(t, err)
+	require.Equal(t, []Money{
+		MustParseMoney("1.99", "usd"), MustParseMoney("10", "usd"), MustParseMoney("10", "usd"), MustParseMoney("10", "usd"),
+	}, item.Charges)
+
+	item, err = QuoteDomain(check, nil, QuoteRegister, 2, before)
+	require.NoError(t, err)
+	require.Equal(t, MustParseMoney("20", "usd"), item.Total)
+
+	premium := &DomainCheck{Domain: "vip.xyz", Available: true, Premium: true, Prices: usdPrices("500", "500", "500", "500")}
+	item, err = QuoteDomain(premium, tld, QuoteRegister, 1, before)
+	require.NoError(t, err)
+	require.Empty(t, item.Promo)
+	require.Equal(t, MustParseMoney("500", "usd"), item.Total)
+
+	_, err = QuoteDomain(&DomainCheck{Domain: "test.xyz", Prices: check.Prices}, tld, QuoteRegister, 1, before)
+	require.ErrorIs(t, err, ErrDomainNotAvailable)
+	_, err = QuoteDomain(&DomainCheck{Domain: "test.xyz", Available: true}, tld, QuoteRegister, 1, before)
+	require.Error(t, err)
+	_, err = QuoteDomain(check, tld, "gift", 1, before)
+	require.ErrorIs(t, err, ErrValidation)
+
+	eur := &TLD{PromoPrices: &Prices{Currency: "eur", Register: MustParseMoney("1", "eur")}, PromoUntil: until}
+	_, err = QuoteDomain(check, eur, QuoteRegister, 2, before)
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestQuote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"tlds", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "tlds.json")
+	})
+	mux.HandleFunc(apiVerPath+"domains/bulk_check", func(w http.ResponseWriter, r *http.Request) {
+		require.Contains(t, r.URL.Query().Get("domains"), "test.xyz")
+		prices := `{"currency":"usd","register":%s,"renew":%s,"transfer":9.79,"redeem":65.79}`
+		fmt.Fprintf(w, `{"data":[
+			{"domain":"test.xyz","available":true,"prices":`+prices+`},
+			{"domain":"vip.xyz","available":true,"premium":true,"prices":`+prices+`},
+			{"domain":"taken.xyz","available":false,"prices":`+prices+`},
+			{"domain":"test.com","available":true,"prices":`+prices+`}
+		]}`, "9.79", "9.79", "100.1", "100.1", "9.79", "9.79", "9.79", "9.79")
+	})
+
+	opts := &QuoteOptions{
+		Domains:   []string{"test.xyz", "vip.xyz", "taken.xyz", "test.com"},
+		Operation: QuoteRegister,
+		Period:    2,
+		At:        wantDate.Add(-time.Hour),
+	}
+	quote, err := client.Quote(opts)
+	require.ErrorIs(t, err, ErrDomainNotAvailable)
+	require.ErrorIs(t, err, ErrTLDNotSupported)
+	require.Len(t, quote.Items, 4)
+
+	require.Equal(t, PromoKindMultiYears, quote.Items[0].Promo)
+	require.Equal(t, MustParseMoney("5.28", "usd"), quote.Items[0].Total)
+	require.NoError(t, quote.Items[0].Err)
+	require.True(t, quote.Items[1].Premium)
+	require.Equal(t, MustParseMoney("200.2", "usd"), quote.Items[1].Total)
+	require.ErrorIs(t, quote.Items[2].Err, ErrDomainNotAvailable)
+	require.ErrorIs(t, quote.Items[3].Err, ErrTLDNotSupported)
+	require.Equal(t, MustParseMoney("205.48", "usd"), quote.Total)
+
+	// promo expired
+	opts.Domains = []string{"test.xyz"}
+	opts.At = wantDate.Add(time.Hour)
+	quote, err = client.QuoteCtx(context.Background(), opts)
+	require.NoError(t, err)
+	require.Empty(t, quote.Items[0].Promo)
+	require.Equal(t, "19.58 usd", quote.Total.String())
+
+	_, err = client.Quote(&QuoteOptions{Domains: []string{"test.xyz"}, Operation: QuoteTransfer, Period: 2})
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	require.Equal(t, "period", verr.Fields[0].Field)
+}
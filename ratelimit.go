@@ -0,0 +1,115 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Represents a token bucket rate limiter
+// It's safe for concurrent use and can be shared between several clients
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// Creates a new rate limiter allowing rps requests per second with bursts up to burst requests
+func NewRateLimiter(rps float64, burst int) (*RateLimiter, error) {
+	if rps <= 0 || math.IsInf(rps, 0) || math.IsNaN(rps) {
+		return nil, errors.New("rate limiter: rps must be a positive number")
+	}
+	if burst < 1 {
+		return nil, errors.New("rate limiter: burst must be at least 1")
+	}
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}, nil
+}
+
+// Wait blocks until a request is allowed or context is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, delay); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// Take a token and return the delay after which it becomes available
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+		l.last = now
+	}
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Return a token reserved by the aborted wait
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// WithRateLimit Limit api client to rps requests per second with bursts up to burst requests
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) error {
+		l, err := NewRateLimiter(rps, burst)
+		if err != nil {
+			return err
+		}
+		c.limiter = l
+		return nil
+	}
+}
+
+// WithRateLimiter Set rate limiter for all requests of api client
+// The same limiter may be passed to several clients to share the limit
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(c *Client) error {
+		c.limiter = l
+		return nil
+	}
+}
+
+// WithWriteRateLimiter Set separate rate limiter for write requests (POST, PUT, DELETE)
+// Read requests keep using the limiter set by WithRateLimit or WithRateLimiter
+func WithWriteRateLimiter(l *RateLimiter) Option {
+	return func(c *Client) error {
+		c.writeLimiter = l
+		return nil
+	}
+}
+
+// Wait for the rate limiter matching the request method
+func (c *Client) waitRateLimit(req *http.Request) error {
+	l := c.limiter
+	if c.writeLimiter != nil && req.Method != http.MethodGet && req.Method != http.MethodHead {
+		l = c.writeLimiter
+	}
+	if l == nil {
+		return nil
+	}
+	return l.Wait(req.Context())
+}
@@ -0,0 +1,75 @@
+package pananames
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	_, err := NewRateLimiter(0, 1)
+	require.Error(t, err)
+	_, err = NewRateLimiter(1, 0)
+	require.Error(t, err)
+	_, err = NewRateLimiter(1, 1)
+	require.NoError(t, err)
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	l, err := NewRateLimiter(50, 2)
+	require.NoError(t, err)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, l.Wait(context.Background()))
+		}()
+	}
+	wg.Wait()
+	// burst of 2 is free, other 4 requests take 20ms each
+	require.GreaterOrEqual(t, time.Since(start), 70*time.Millisecond)
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	l, err := NewRateLimiter(0.1, 1)
+	require.NoError(t, err)
+	require.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+	// the canceled wait returns its token
+	require.InDelta(t, 0, l.tokens, 0.01)
+}
+
+func TestWriteRateLimiter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	read, err := NewRateLimiter(1000, 10)
+	require.NoError(t, err)
+	write, err := NewRateLimiter(0.1, 1)
+	require.NoError(t, err)
+	require.NoError(t, client.parseOptions(WithRateLimiter(read), WithWriteRateLimiter(write)))
+
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "domain.json")
+	})
+
+	// write bucket is exhausted by the first call
+	require.NoError(t, client.DeleteDomain("test.com"))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, client.DeleteDomain("test.com", WithContext(ctx)), context.DeadlineExceeded)
+
+	// reads use their own bucket
+	_, err = client.GetDomain("test.com")
+	require.NoError(t, err)
+}
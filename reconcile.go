@@ -0,0 +1,270 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	defaultReconcileAttempts = 3
+	defaultReconcileDelay    = 2 * time.Second
+	defaultClockSkew         = time.Minute
+)
+
+// Represents the real outcome of a non-idempotent operation
+type ReconcileOutcome int
+
+const (
+	// Operation result is unknown, it must not be retried before manual check
+	OutcomeUnknown ReconcileOutcome = iota
+	// Operation is completed
+	OutcomeSucceeded
+	// Operation is not completed and no charge was made
+	OutcomeFailed
+)
+
+// Represents settings of reconciling operations
+type ReconcileOptions struct {
+	// Max number of operation attempts, 3 if zero
+	MaxAttempts int
+	// Delay before checking the account state or making the next attempt, 2s if zero
+	Delay time.Duration
+	// Allowed difference between local and API clocks when matching payments, 1m if zero
+	ClockSkew time.Duration
+}
+
+// Represents the result of reconciled operation
+type ReconcileResult struct {
+	Outcome ReconcileOutcome
+	// Number of operation attempts made
+	Attempts int
+	// Outcome was determined by checking the account state after ambiguous failure
+	Reconciled bool
+	// Registered domain, set by RegisterDomainReconciled()
+	Domain *Domain
+	// Initiated transfer, set by InitTransferInReconciled()
+	TransferIn *TransferIn
+	// Payment for the domain made after the operation start, if any
+	Payment *Payment
+}
+
+// Represents a check of the account state, reports whether the operation is done
+type reconcileCheck func(ctx context.Context) (found bool, err error)
+
+func (o ReconcileOutcome) String() string {
+	switch o {
+	case OutcomeSucceeded:
+		return "succeeded"
+	case OutcomeFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// Register a domain, reconciling ambiguous failures
+// If the request fails without a definite answer (timeout, connection reset, 5xx),
+// the domain and account payments are checked to find out whether it was registered.
+// The registration is retried only when it's certainly not done
+func (c *Client) RegisterDomainReconciled(ctx context.Context, opt *RegisterDomainOptions, ropt *ReconcileOptions) (*ReconcileResult, error) {
+	if opt == nil || opt.Domain == "" {
+		return &ReconcileResult{Outcome: OutcomeFailed}, fmt.Errorf("%T domain can't be empty", opt)
+	}
+	result := &ReconcileResult{}
+
+	// The domain must not be in the account yet, otherwise it can't prove the registration
+	if _, err := c.GetDomainCtx(ctx, opt.Domain); err == nil {
+		result.Outcome = OutcomeFailed
+		return result, fmt.Errorf("domain %s is already in the account: %w", opt.Domain, ErrDomainNotAvailable)
+	} else if !errors.Is(err, ErrNotFound) {
+		result.Outcome = OutcomeFailed
+		return result, err
+	}
+
+	attempt := func(ctx context.Context) error {
+		d, err := c.RegisterDomainCtx(ctx, opt)
+		if err == nil {
+			result.Domain = d
+		}
+		return err
+	}
+	check := func(ctx context.Context) (bool, error) {
+		d, err := c.GetDomainCtx(ctx, opt.Domain)
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		result.Domain = d
+		return true, nil
+	}
+
+	return c.reconcile(ctx, opt.Domain, ropt, result, attempt, check)
+}
+
+// Initiate transfer in, reconciling ambiguous failures
+// If the request fails without a definite answer (timeout, connection reset, 5xx),
+// active transfers and account payments are checked to find out whether it was initiated.
+// The transfer is retried only when it's certainly not initiated
+func (c *Client) InitTransferInReconciled(ctx context.Context, opt *InitTransferInOptions, ropt *ReconcileOptions) (*ReconcileResult, error) {
+	if opt == nil || opt.Domain == "" {
+		return &ReconcileResult{Outcome: OutcomeFailed}, fmt.Errorf("%T domain can't be empty", opt)
+	}
+	result := &ReconcileResult{}
+
+	find := func(ctx context.Context) (*TransferIn, error) {
+		transfers, _, err := c.GetTransfersInCtx(ctx, &GetTransfersInOptions{DomainLike: opt.Domain})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range transfers {
+			if strings.EqualFold(t.Domain, opt.Domain) {
+				return t, nil
+			}
+		}
+		return nil, nil
+	}
+
+	// The transfer must not be active yet, otherwise it can't prove the initiation
+	if t, err := find(ctx); err != nil {
+		result.Outcome = OutcomeFailed
+		return result, err
+	} else if t != nil {
+		result.Outcome = OutcomeFailed
+		return result, fmt.Errorf("transfer of %s is already in progress", opt.Domain)
+	}
+
+	attempt := func(ctx context.Context) error {
+		t, err := c.InitTransferInCtx(ctx, opt)
+		if err == nil {
+			result.TransferIn = t
+		}
+		return err
+	}
+	check := func(ctx context.Context) (bool, error) {
+		t, err := find(ctx)
+		if err != nil || t == nil {
+			return false, err
+		}
+		result.TransferIn = t
+		return true, nil
+	}
+
+	return c.reconcile(ctx, opt.Domain, ropt, result, attempt, check)
+}
+
+// Run the operation attempts, checking the account state after ambiguous failures
+func (c *Client) reconcile(ctx context.Context, domain string, ropt *ReconcileOptions, result *ReconcileResult, attempt func(context.Context) error, check reconcileCheck) (*ReconcileResult, error) {
+	o := ReconcileOptions{}
+	if ropt != nil {
+		o = *ropt
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = defaultReconcileAttempts
+	}
+	if o.Delay <= 0 {
+		o.Delay = defaultReconcileDelay
+	}
+	if o.ClockSkew <= 0 {
+		o.ClockSkew = defaultClockSkew
+	}
+	start := time.Now().Add(-o.ClockSkew)
+
+	var err error
+	// Some attempt failed ambiguously, it may still be done later
+	ambiguous := false
+	for result.Attempts < o.MaxAttempts {
+		result.Attempts++
+		if err = attempt(ctx); err == nil {
+			result.Outcome = OutcomeSucceeded
+			result.Payment, _ = c.findPayment(ctx, domain, start)
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			result.Outcome = OutcomeUnknown
+			return result, err
+		}
+		// Successful response which can't be decoded means the operation is done
+		accepted := acceptedResponse(err)
+		// Conflict after an ambiguous attempt may be caused by that attempt
+		conflict := ambiguous && errors.Is(err, ErrDomainNotAvailable)
+		if !requestSent(err) || (rejected(err) && !notProcessed(err) && !conflict) {
+			// The API definitely rejected the operation or it wasn't sent
+			result.Outcome = OutcomeFailed
+			if ambiguous {
+				result.Outcome = OutcomeUnknown
+			}
+			return result, err
+		}
+
+		if sleepErr := sleepContext(ctx, o.Delay); sleepErr != nil {
+			result.Outcome = OutcomeUnknown
+			return result, sleepErr
+		}
+		if !accepted && notProcessed(err) {
+			continue
+		}
+
+		// Ambiguous failure, e.g. timeout, server error or connection reset, check whether the operation is done
+		ambiguous = true
+		result.Reconciled = true
+		found, checkErr := check(ctx)
+		if checkErr != nil {
+			result.Outcome = OutcomeUnknown
+			return result, fmt.Errorf("unable to reconcile after %v: %w", err, checkErr)
+		}
+		payment, checkErr := c.findPayment(ctx, domain, start)
+		if checkErr != nil {
+			result.Outcome = OutcomeUnknown
+			return result, fmt.Errorf("unable to reconcile after %v: %w", err, checkErr)
+		}
+		result.Payment = payment
+		if found {
+			result.Outcome = OutcomeSucceeded
+			return result, nil
+		}
+		if payment != nil {
+			// Charged but not visible yet
+			result.Outcome = OutcomeUnknown
+			return result, fmt.Errorf("payment %s for %s found but operation is not visible: %w", payment.TxID, domain, err)
+		}
+		if accepted {
+			// Must not be retried, the account may be charged twice
+			result.Outcome = OutcomeUnknown
+			return result, fmt.Errorf("operation on %s accepted but is not visible: %w", domain, err)
+		}
+		if conflict {
+			// Earlier attempt may be in progress
+			result.Outcome = OutcomeUnknown
+			return result, fmt.Errorf("operation on %s conflicts but earlier attempts are not visible: %w", domain, err)
+		}
+	}
+
+	// Earlier ambiguous attempts may still be done
+	result.Outcome = OutcomeFailed
+	if ambiguous {
+		result.Outcome = OutcomeUnknown
+	}
+	return result, err
+}
+
+// Find the latest payment for the domain made after the time
+func (c *Client) findPayment(ctx context.Context, domain string, since time.Time) (*Payment, error) {
+	payments, _, err := c.GetAccountPaymentsCtx(ctx, &GetAccountPaymentsOptions{DomainLike: domain})
+	if err != nil {
+		return nil, err
+	}
+	var latest *Payment
+	for _, p := range payments {
+		if !strings.EqualFold(p.Domain, domain) || p.TxDate == nil || p.TxDate.Before(since) {
+			continue
+		}
+		if latest == nil || p.TxDate.After(latest.TxDate.Time) {
+			latest = p
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,289 @@
+package pananames
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testReconcileOptions = &ReconcileOptions{Delay: time.Millisecond}
+
+const emptyListResponse = `{"meta":{"current_page":1,"per_page":30,"total_entries":0,"total_pages":0},"data":[]}`
+
+func TestRegisterDomainReconciledFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var registered int32
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		// registered, but the response is lost
+		atomic.StoreInt32(&registered, 1)
+		w.WriteHeader(http.StatusGatewayTimeout)
+	})
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&registered) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"code":404,"message":"Not found"}]}`))
+			return
+		}
+		writeFixture(t, w, "domain.json")
+	})
+	mux.HandleFunc(apiVerPath+"account/payments", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "domain_like=test.com", r.URL.RawQuery)
+		writeFixture(t, w, "payments.json")
+	})
+
+	got, err := client.RegisterDomainReconciled(context.Background(), newRegisterOptions("test.com"), testReconcileOptions)
+	require.NoError(t, err)
+	require.Equal(t, OutcomeSucceeded, got.Outcome)
+	require.True(t, got.Reconciled)
+	require.Equal(t, 1, got.Attempts)
+	require.Equal(t, wantDomainInfo, got.Domain)
+	// payment fixture is older than the operation
+	require.Nil(t, got.Payment)
+}
+
+func TestRegisterDomainReconciledRetry(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var calls int32
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		writeFixture(t, w, "domain.json")
+	})
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":404,"message":"Not found"}]}`))
+	})
+	mux.HandleFunc(apiVerPath+"account/payments", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(emptyListResponse))
+	})
+
+	got, err := client.RegisterDomainReconciled(context.Background(), newRegisterOptions("test.com"), testReconcileOptions)
+	require.NoError(t, err)
+	require.Equal(t, OutcomeSucceeded, got.Outcome)
+	require.Equal(t, 2, got.Attempts)
+	require.Equal(t, wantDomainInfo, got.Domain)
+}
+
+func TestRegisterDomainReconciledRejected(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var calls int32
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"errors":[{"code":422,"message":"Invalid period"}]}`))
+	})
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":404,"message":"Not found"}]}`))
+	})
+
+	got, err := client.RegisterDomainReconciled(context.Background(), newRegisterOptions("test.com"), testReconcileOptions)
+	require.ErrorIs(t, err, ErrValidation)
+	require.Equal(t, OutcomeFailed, got.Outcome)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRegisterDomainReconciledUnknownStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var registered int32
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		// proxy status which doesn't tell whether the request was processed
+		atomic.StoreInt32(&registered, 1)
+		w.WriteHeader(522)
+	})
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&registered) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"code":404,"message":"Not found"}]}`))
+			return
+		}
+		writeFixture(t, w, "domain.json")
+	})
+	mux.HandleFunc(apiVerPath+"account/payments", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(emptyListResponse))
+	})
+
+	got, err := client.RegisterDomainReconciled(context.Background(), newRegisterOptions("test.com"), testReconcileOptions)
+	require.NoError(t, err)
+	require.Equal(t, OutcomeSucceeded, got.Outcome)
+	require.True(t, got.Reconciled)
+	require.Equal(t, 1, got.Attempts)
+}
+
+func TestRegisterDomainReconciledConflict(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var calls, registered int32
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusGatewayTimeout)
+			return
+		}
+		// the first attempt is completed by now
+		atomic.StoreInt32(&registered, 1)
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"errors":[{"code":409,"message":"Domain is already registered"}]}`))
+	})
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&registered) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"code":404,"message":"Not found"}]}`))
+			return
+		}
+		writeFixture(t, w, "domain.json")
+	})
+	mux.HandleFunc(apiVerPath+"account/payments", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(emptyListResponse))
+	})
+
+	got, err := client.RegisterDomainReconciled(context.Background(), newRegisterOptions("test.com"), testReconcileOptions)
+	require.NoError(t, err)
+	require.Equal(t, OutcomeSucceeded, got.Outcome)
+	require.Equal(t, 2, got.Attempts)
+	require.Equal(t, wantDomainInfo, got.Domain)
+}
+
+func TestRegisterDomainReconciledExhausted(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGatewayTimeout)
+	})
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":404,"message":"Not found"}]}`))
+	})
+	mux.HandleFunc(apiVerPath+"account/payments", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(emptyListResponse))
+	})
+
+	// earlier attempts may still be completed
+	got, err := client.RegisterDomainReconciled(context.Background(), newRegisterOptions("test.com"), testReconcileOptions)
+	require.Error(t, err)
+	require.Equal(t, OutcomeUnknown, got.Outcome)
+	require.Equal(t, 3, got.Attempts)
+}
+
+func TestRegisterDomainReconciledAlreadyOwned(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "domain.json")
+	})
+
+	got, err := client.RegisterDomainReconciled(context.Background(), newRegisterOptions("test.com"), testReconcileOptions)
+	require.ErrorIs(t, err, ErrDomainNotAvailable)
+	require.Equal(t, OutcomeFailed, got.Outcome)
+	require.Equal(t, 0, got.Attempts)
+}
+
+func TestInitTransferInReconciledFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var initiated int32
+	mux.HandleFunc(apiVerPath+"transfers_in", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			atomic.StoreInt32(&initiated, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		case http.MethodGet:
+			require.Equal(t, "domain_like=test.com", r.URL.RawQuery)
+			if atomic.LoadInt32(&initiated) == 0 {
+				w.Write([]byte(emptyListResponse))
+				return
+			}
+			writeFixture(t, w, "transfers_in.json")
+		}
+	})
+	mux.HandleFunc(apiVerPath+"account/payments", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(emptyListResponse))
+	})
+
+	got, err := client.InitTransferInReconciled(context.Background(), &InitTransferInOptions{Domain: "test.com", AuthCode: "code"}, testReconcileOptions)
+	require.NoError(t, err)
+	require.Equal(t, OutcomeSucceeded, got.Outcome)
+	require.True(t, got.Reconciled)
+	require.Equal(t, wantTransferInInfo, got.TransferIn)
+}
+
+func TestReconcileChargedButNotVisible(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGatewayTimeout)
+	})
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":404,"message":"Not found"}]}`))
+	})
+	mux.HandleFunc(apiVerPath+"account/payments", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[{"txid":"1","txdate":"` + time.Now().UTC().Format(time.RFC3339) + `","txtype":"create","domain":"test.com","total":-1.23}]}`))
+	})
+
+	got, err := client.RegisterDomainReconciled(context.Background(), newRegisterOptions("test.com"), testReconcileOptions)
+	require.Error(t, err)
+	require.Equal(t, OutcomeUnknown, got.Outcome)
+	require.Equal(t, "1", got.Payment.TxID)
+	require.Equal(t, 1, got.Attempts)
+}
+
+func TestRegisterDomainReconciledUndecodable(t *testing.T) {
+	for _, visible := range []bool{true, false} {
+		mux, server, client := setup(t)
+
+		var calls, registered int32
+		mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+			// registered, but the response has no data
+			atomic.AddInt32(&calls, 1)
+			if visible {
+				atomic.StoreInt32(&registered, 1)
+			}
+			w.Write([]byte(`{"meta":{}}`))
+		})
+		mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+			if atomic.LoadInt32(&registered) == 0 {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"errors":[{"code":404,"message":"Not found"}]}`))
+				return
+			}
+			writeFixture(t, w, "domain.json")
+		})
+		mux.HandleFunc(apiVerPath+"account/payments", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(emptyListResponse))
+		})
+
+		got, err := client.RegisterDomainReconciled(context.Background(), newRegisterOptions("test.com"), testReconcileOptions)
+		if visible {
+			require.NoError(t, err)
+			require.Equal(t, OutcomeSucceeded, got.Outcome)
+			require.Equal(t, wantDomainInfo, got.Domain)
+		} else {
+			var decodeErr *DecodeError
+			require.ErrorAs(t, err, &decodeErr)
+			require.Equal(t, OutcomeUnknown, got.Outcome)
+		}
+		require.True(t, got.Reconciled)
+		require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		teardown(server)
+	}
+}
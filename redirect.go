@@ -1,6 +1,7 @@
 package pananames
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -37,68 +38,87 @@ type EnableBulkDomainRedirectOptions struct {
 	DomainList []string `json:"domain_list,omitempty"`
 }
 
-// Get current redirect URL and mode for the domain
-func (c *Client) GetDomainRedirect(domain string, options ...RequestOptionFunc) (*Redirect, error) {
-	u := fmt.Sprintf("domains/%s/redirect", url.PathEscape(domain))
+// Validate EnableDomainRedirectOptions for redirect URL
+func (opt *EnableDomainRedirectOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	opt.validate(&e)
+	return e.err(opt)
+}
 
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
+// Validate EnableBulkDomainRedirectOptions for redirect URL and domain names
+func (opt *EnableBulkDomainRedirectOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	opt.EnableDomainRedirectOptions.validate(&e)
+	if len(opt.DomainList) == 0 {
+		e.add("domain_list", "is required")
 	}
+	for i, d := range opt.DomainList {
+		e.domain(fmt.Sprintf("domain_list[%d]", i), d)
+	}
+	return e.err(opt)
+}
 
-	result := new(Redirect)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
+func (opt *EnableDomainRedirectOptions) validate(e *fieldErrors) {
+	if opt.Url == "" {
+		e.add("url", "is required")
+	} else if !validURL(opt.Url) {
+		e.add("url", "%q is not an absolute http or https URL", opt.Url)
 	}
+}
+
+// Get current redirect URL and mode for the domain
+func (c *Client) GetDomainRedirect(domain string, options ...RequestOptionFunc) (*Redirect, error) {
+	return c.GetDomainRedirectCtx(context.Background(), domain, options...)
+}
+
+// Same as GetDomainRedirect() with the request context
+func (c *Client) GetDomainRedirectCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*Redirect, error) {
+	u := fmt.Sprintf("domains/%s/redirect", url.PathEscape(domain))
 
-	return result, nil
+	options = append([]RequestOptionFunc{operation("GetDomainRedirect", domain)}, options...)
+	return Call[*Redirect](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Enable or update redirect for the domain
 func (c *Client) EnableDomainRedirect(domain string, opt *EnableDomainRedirectOptions, options ...RequestOptionFunc) (*Redirect, error) {
-	u := fmt.Sprintf("domains/%s/redirect", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
-	result := new(Redirect)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.EnableDomainRedirectCtx(context.Background(), domain, opt, options...)
+}
 
-	return result, nil
+// Same as EnableDomainRedirect() with the request context
+func (c *Client) EnableDomainRedirectCtx(ctx context.Context, domain string, opt *EnableDomainRedirectOptions, options ...RequestOptionFunc) (*Redirect, error) {
+	u := fmt.Sprintf("domains/%s/redirect", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("EnableDomainRedirect", domain)}, options...)
+	return Call[*Redirect](c, ctx, http.MethodPut, u, opt, options...)
 }
 
 // Disable redirect for the domain
 func (c *Client) DisableDomainRedirect(domain string, options ...RequestOptionFunc) error {
-	u := fmt.Sprintf("domains/%s/redirect", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodDelete, u, nil, options)
-	if err != nil {
-		return err
-	}
-	_, err = c.Do(req, nil)
-	if err != nil {
-		return err
-	}
+	return c.DisableDomainRedirectCtx(context.Background(), domain, options...)
+}
 
+// Same as DisableDomainRedirect() with the request context
+func (c *Client) DisableDomainRedirectCtx(ctx context.Context, domain string, options ...RequestOptionFunc) error {
+	u := fmt.Sprintf("domains/%s/redirect", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("DisableDomainRedirect", domain)}, options...)
+	_, err := Call[struct{}](c, ctx, http.MethodDelete, u, nil, options...)
 	return err
 }
 
 // Puts in the queue the task of redirect for an array of domains
 // The report of the operation will be sent by email
 func (c *Client) EnableBulkDomainRedirect(opt *EnableBulkDomainRedirectOptions, options ...RequestOptionFunc) (*RedirectBulk, error) {
-	u := "domains/bulk_redirect"
-	req, err := c.NewRequest(http.MethodPut, u, opt, options)
-	if err != nil {
-		return nil, err
-	}
-	result := new(RedirectBulk)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.EnableBulkDomainRedirectCtx(context.Background(), opt, options...)
+}
 
-	return result, nil
+// Same as EnableBulkDomainRedirect() with the request context
+func (c *Client) EnableBulkDomainRedirectCtx(ctx context.Context, opt *EnableBulkDomainRedirectOptions, options ...RequestOptionFunc) (*RedirectBulk, error) {
+	u := "domains/bulk_redirect"
+	options = append([]RequestOptionFunc{operation("EnableBulkDomainRedirect", ""), nonIdempotent()}, options...)
+	return Call[*RedirectBulk](c, ctx, http.MethodPut, u, opt, options...)
 }
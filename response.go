@@ -0,0 +1,61 @@
+package pananames
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Header carrying the API request ID
+const RequestIDHeader = "X-Request-Id"
+
+// Represents metadata of the API response
+type ResponseInfo struct {
+	StatusCode int
+	Header     http.Header
+	RequestID  string
+	Pagination Pagination
+	Notice     string
+}
+
+// WithResponseInfo Fill info with metadata of the API response
+// The info is filled for failed requests too if the API responded.
+// It's ignored by GetAll* methods fetching pages concurrently
+func WithResponseInfo(info *ResponseInfo) RequestOptionFunc {
+	return func(req *http.Request) error {
+		if info == nil {
+			return errors.New("response info can't be nil")
+		}
+		if cfg := getRequestConfig(req); cfg != nil {
+			cfg.info = info
+		}
+		return nil
+	}
+}
+
+// Drop response info of requests sent concurrently with the same options, they would fill it at once
+func withoutResponseInfo(options []RequestOptionFunc) []RequestOptionFunc {
+	return append(options[:len(options):len(options)], func(req *http.Request) error {
+		if cfg := getRequestConfig(req); cfg != nil {
+			cfg.info = nil
+		}
+		return nil
+	})
+}
+
+// Fill response info of the request from http response
+func setResponseInfo(req *http.Request, resp *http.Response, result *Response) {
+	cfg := getRequestConfig(req)
+	if cfg == nil || cfg.info == nil {
+		return
+	}
+	info := ResponseInfo{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		RequestID:  resp.Header.Get(RequestIDHeader),
+	}
+	if result != nil {
+		info.Pagination = result.Meta.Pagination
+		info.Notice = result.Meta.Notice
+	}
+	*cfg.info = info
+}
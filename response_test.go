@@ -0,0 +1,55 @@
+package pananames
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithResponseInfo(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"domains/test.com/renew", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-1")
+		w.Write([]byte(`{"meta":{"notice":"Renewal is delayed"},"data":{"domain":"test.com"}}`))
+	})
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "domains.json")
+	})
+
+	info := &ResponseInfo{}
+	_, err := client.RenewDomain("test.com", &RenewDomainOptions{Period: "1"}, WithResponseInfo(info))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, info.StatusCode)
+	require.Equal(t, "req-1", info.RequestID)
+	require.Equal(t, "req-1", info.Header.Get(RequestIDHeader))
+	require.Equal(t, "Renewal is delayed", info.Notice)
+
+	_, _, err = client.GetDomains(nil, WithResponseInfo(info))
+	require.NoError(t, err)
+	require.Equal(t, "", info.RequestID)
+	require.Equal(t, "", info.Notice)
+	require.NotZero(t, info.Pagination.Pages)
+}
+
+func TestWithResponseInfoError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"domains/test.com", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-2")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":404,"message":"Domain not found"}]}`))
+	})
+
+	info := &ResponseInfo{}
+	_, err := client.GetDomain("test.com", WithResponseInfo(info))
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Equal(t, http.StatusNotFound, info.StatusCode)
+	require.Equal(t, "req-2", info.RequestID)
+
+	_, err = client.GetDomain("test.com", WithResponseInfo(nil))
+	require.Error(t, err)
+}
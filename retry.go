@@ -0,0 +1,175 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// Represents a policy of retrying failed API requests
+// Only idempotent requests are retried: GET and PUT/DELETE of idempotent endpoints
+type RetryPolicy struct {
+	// Max number of retries after the first attempt
+	MaxRetries int
+	// Base delay before the first retry, doubled on every next one
+	MinBackoff time.Duration
+	// Upper bound of the delay between attempts
+	// Retry-After longer than MaxBackoff stops retrying
+	MaxBackoff time.Duration
+	// HTTP status codes considered transient
+	// 429, 502, 503 and 504 are used if empty
+	StatusCodes []int
+}
+
+// Returns retry policy with default settings
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:  defaultMaxRetries,
+		MinBackoff:  defaultMinBackoff,
+		MaxBackoff:  defaultMaxBackoff,
+		StatusCodes: []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+	}
+}
+
+// WithRetryPolicy Set retry policy for api client
+// Pass nil to disable retries
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) error {
+		if policy == nil {
+			c.retryPolicy = nil
+			return nil
+		}
+		p := *policy
+		if p.MaxRetries < 0 {
+			return errors.New("retry policy: MaxRetries can't be negative")
+		}
+		if p.MinBackoff <= 0 {
+			p.MinBackoff = defaultMinBackoff
+		}
+		if p.MaxBackoff < p.MinBackoff {
+			p.MaxBackoff = p.MinBackoff
+		}
+		if len(p.StatusCodes) == 0 {
+			p.StatusCodes = DefaultRetryPolicy().StatusCodes
+		}
+		c.retryPolicy = &p
+		return nil
+	}
+}
+
+// Mark request as non-idempotent, such request is never retried
+func nonIdempotent() RequestOptionFunc {
+	return func(req *http.Request) error {
+		if cfg := getRequestConfig(req); cfg != nil {
+			cfg.nonIdempotent = true
+		}
+		return nil
+	}
+}
+
+// Check if request can be safely sent again
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPut, http.MethodDelete:
+		cfg := getRequestConfig(req)
+		return cfg == nil || !cfg.nonIdempotent
+	}
+	return false
+}
+
+// Check if the attempt result is transient and can be retried
+func (p *RetryPolicy) retryable(req *http.Request, resp *http.Response, err error) bool {
+	if !isIdempotent(req) {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if resp == nil {
+		// Transport error, do not retry when the request context is done
+		return req.Context().Err() == nil && IsTemporary(err)
+	}
+	for _, code := range p.StatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Calculate the delay before the next attempt
+// Returns false if server asks to wait longer than MaxBackoff
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return d, d <= p.MaxBackoff
+		}
+	}
+
+	d := p.MaxBackoff
+	if attempt < 32 {
+		if exp := p.MinBackoff << uint(attempt); exp > 0 && exp < p.MaxBackoff {
+			d = exp
+		}
+	}
+	// Equal jitter: half of the delay is fixed, the other half is random
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1)), true
+}
+
+// Parse Retry-After header value as delay seconds or HTTP date
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// Clone request for another attempt, rewinding its body
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return r, nil
+}
+
+// Wait for the given duration or until context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,158 @@
+package pananames
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryPolicy = &RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: time.Millisecond,
+	MaxBackoff: 10 * time.Millisecond,
+}
+
+func TestRetryTransientStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	require.NoError(t, client.parseOptions(WithRetryPolicy(testRetryPolicy)))
+
+	var calls int32
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"errors":[{"code":503,"message":"unavailable"}]}`))
+			return
+		}
+		writeFixture(t, w, "balance.json")
+	})
+
+	got, err := client.GetAccountBalance()
+	require.NoError(t, err)
+	require.Equal(t, &Balance{MustParseMoney("12.34", "")}, got)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	require.NoError(t, client.parseOptions(WithRetryPolicy(testRetryPolicy)))
+
+	var calls int32
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(`{"errors":[{"code":502,"message":"bad gateway"}]}`))
+	})
+
+	_, err := client.GetAccountBalance()
+	require.Error(t, err)
+	require.IsType(t, &ErrorResponse{}, err)
+	require.Equal(t, int32(4), atomic.LoadInt32(&calls))
+}
+
+func TestRetryRewindsBody(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	require.NoError(t, client.parseOptions(WithRetryPolicy(testRetryPolicy)))
+
+	var calls int32
+	mux.HandleFunc(apiVerPath+"domains/test.com/name_servers", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, `{"name_servers":["ns1.test.com","ns2.test.com"]}`, getBody(t, r))
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"errors":[{"code":429,"message":"too many requests"}]}`))
+			return
+		}
+		writeFixture(t, w, "nameservers.json")
+	})
+
+	_, err := client.SetNameServers("test.com", &SetNameServersOptions{NameServers: NameServers{"ns1.test.com", "ns2.test.com"}})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestRetrySkipsNonIdempotent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	require.NoError(t, client.parseOptions(WithRetryPolicy(testRetryPolicy)))
+
+	var calls int32
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"errors":[{"code":503,"message":"unavailable"}]}`))
+	}
+	mux.HandleFunc(apiVerPath+"domains", handler)
+	mux.HandleFunc(apiVerPath+"domains/test.com/renew", handler)
+
+	_, err := client.RegisterDomain(newRegisterOptions("test.com"))
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	_, err = client.RenewDomain("test.com", &RenewDomainOptions{Period: "1"})
+	require.Error(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestRetryAfterTooLong(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	require.NoError(t, client.parseOptions(WithRetryPolicy(testRetryPolicy)))
+
+	var calls int32
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"errors":[{"code":429,"message":"too many requests"}]}`))
+	})
+
+	_, err := client.GetAccountBalance()
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	require.NoError(t, client.parseOptions(WithRetryPolicy(&RetryPolicy{MaxRetries: 5, MinBackoff: time.Hour, MaxBackoff: time.Hour})))
+
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"errors":[{"code":503,"message":"unavailable"}]}`))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.GetAccountBalance(WithContext(ctx))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	d, ok := parseRetryAfter("3")
+	require.True(t, ok)
+	require.Equal(t, 3*time.Second, d)
+
+	d, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), d)
+
+	_, ok = parseRetryAfter("soon")
+	require.False(t, ok)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, max := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		d, ok := p.backoff(attempt, nil)
+		require.True(t, ok)
+		require.GreaterOrEqual(t, d, max/2)
+		require.LessOrEqual(t, d, max)
+	}
+}
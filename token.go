@@ -0,0 +1,125 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Represents a source of API token, consulted on every request
+// Implementations must be safe for concurrent use
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// Represents a constant token
+type StaticToken string
+
+// Represents an adapter to use ordinary function as TokenProvider
+type TokenFunc func(ctx context.Context) (string, error)
+
+// Represents a token read from environment variable on every request
+type envToken struct {
+	name string
+}
+
+// Represents a token read from file, the file is re-read when it's changed
+type fileToken struct {
+	path    string
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// Token returns the token itself
+func (t StaticToken) Token(ctx context.Context) (string, error) {
+	return string(t), nil
+}
+
+// Token calls f(ctx)
+func (f TokenFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// Creates a provider reading token from the environment variable
+func EnvToken(name string) TokenProvider {
+	return &envToken{name: name}
+}
+
+// Creates a provider reading token from the file
+// The file is read again when its modification time or size changes
+func FileToken(path string) TokenProvider {
+	return &fileToken{path: path}
+}
+
+func (t *envToken) Token(ctx context.Context) (string, error) {
+	token := strings.TrimSpace(os.Getenv(t.name))
+	if token == "" {
+		return "", fmt.Errorf("token: environment variable %s is empty", t.name)
+	}
+	return token, nil
+}
+
+func (t *fileToken) Token(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return "", fmt.Errorf("token: %w", err)
+	}
+	if t.token != "" && info.ModTime().Equal(t.modTime) && info.Size() == t.size {
+		return t.token, nil
+	}
+
+	data, err := os.ReadFile(t.path)
+	if err != nil {
+		return "", fmt.Errorf("token: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token: file %s is empty", t.path)
+	}
+	t.token, t.modTime, t.size = token, info.ModTime(), info.Size()
+	return token, nil
+}
+
+// WithTokenProvider Set token provider for api client, it replaces the token passed to NewClient
+func WithTokenProvider(provider TokenProvider) Option {
+	return func(c *Client) error {
+		if provider == nil {
+			return errors.New("token provider can't be nil")
+		}
+		c.SetTokenProvider(provider)
+		return nil
+	}
+}
+
+// SetToken Replace the token of api client, safe to call while requests are running
+func (c *Client) SetToken(token string) {
+	c.SetTokenProvider(StaticToken(token))
+}
+
+// SetTokenProvider Replace the token provider of api client, safe to call while requests are running
+func (c *Client) SetTokenProvider(provider TokenProvider) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.tokenProvider = provider
+}
+
+// Get the current token from the provider
+func (c *Client) token(ctx context.Context) (string, error) {
+	c.tokenMu.RLock()
+	provider := c.tokenProvider
+	c.tokenMu.RUnlock()
+
+	if provider == nil {
+		return "", nil
+	}
+	return provider.Token(ctx)
+}
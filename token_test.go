@@ -0,0 +1,98 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var got []string
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("SIGNATURE"))
+		writeFixture(t, w, "balance.json")
+	})
+
+	_, err := client.GetAccountBalance()
+	require.NoError(t, err)
+	client.SetToken("rotated")
+	_, err = client.GetAccountBalance()
+	require.NoError(t, err)
+	require.Equal(t, []string{"secret", "rotated"}, got)
+}
+
+func TestSetTokenConcurrent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "balance.json")
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetAccountBalance()
+			require.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			client.SetToken("rotated")
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTokenFuncError(t *testing.T) {
+	wantErr := errors.New("vault unavailable")
+	client, err := NewClient("", WithTokenProvider(TokenFunc(func(ctx context.Context) (string, error) {
+		return "", wantErr
+	})))
+	require.NoError(t, err)
+
+	_, err = client.GetAccountBalance()
+	require.ErrorIs(t, err, wantErr)
+}
+
+func TestEnvToken(t *testing.T) {
+	t.Setenv("PANANAMES_TEST_TOKEN", " env-token\n")
+	got, err := EnvToken("PANANAMES_TEST_TOKEN").Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "env-token", got)
+
+	_, err = EnvToken("PANANAMES_TEST_MISSING_TOKEN").Token(context.Background())
+	require.Error(t, err)
+}
+
+func TestFileToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+
+	provider := FileToken(path)
+	got, err := provider.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "first", got)
+
+	require.NoError(t, os.WriteFile(path, []byte("second-token\n"), 0o600))
+	later := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(path, later, later))
+	got, err = provider.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "second-token", got)
+
+	require.NoError(t, os.Remove(path))
+	_, err = provider.Token(context.Background())
+	require.Error(t, err)
+}
@@ -0,0 +1,65 @@
+package pananames
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// Span attribute keys set by api client
+const (
+	AttrOperation    = "pananames.operation"
+	AttrDomain       = "pananames.domain"
+	AttrMethod       = "http.request.method"
+	AttrStatusCode   = "http.response.status_code"
+	AttrAPIErrorCode = "pananames.error_code"
+)
+
+// Represents a tracer starting a span for every API operation
+// See the oteltracer sub-package for OpenTelemetry adapter
+type Tracer interface {
+	// Start a span as a child of the span in ctx, if any
+	// Returned context is used for the HTTP request
+	Start(ctx context.Context, operation string) (context.Context, Span)
+}
+
+// Represents a span of a single API operation
+type Span interface {
+	// Set span attribute, value is string, int or bool
+	SetAttribute(key string, value interface{})
+	// Finish the span, err is nil for successful operation
+	End(err error)
+}
+
+// WithTracer Trace every request of api client with the tracer
+func WithTracer(tracer Tracer) Option {
+	return func(c *Client) error {
+		c.tracer = tracer
+		return nil
+	}
+}
+
+// Build middleware wrapping request into a span
+func (c *Client) tracingMiddleware(next Doer) Doer {
+	tracer := c.tracer
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		ctx, span := tracer.Start(req.Context(), operationName(req))
+		span.SetAttribute(AttrOperation, operationName(req))
+		span.SetAttribute(AttrMethod, req.Method)
+		if cfg := getRequestConfig(req); cfg != nil && cfg.domain != "" {
+			span.SetAttribute(AttrDomain, cfg.domain)
+		}
+
+		resp, err := next.Do(withRequestContext(req, ctx))
+		if resp != nil {
+			span.SetAttribute(AttrStatusCode, resp.StatusCode)
+		}
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) && len(errResp.Errors) > 0 {
+			span.SetAttribute(AttrAPIErrorCode, errResp.Errors[0].Code)
+		}
+		span.End(err)
+
+		return resp, err
+	})
+}
@@ -0,0 +1,99 @@
+package pananames
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testSpanKey struct{}
+
+type testSpan struct {
+	operation string
+	parent    *testSpan
+	attrs     map[string]interface{}
+	err       error
+	ended     bool
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, operation string) (context.Context, Span) {
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	s := &testSpan{operation: operation, parent: parent, attrs: map[string]interface{}{}}
+	t.spans = append(t.spans, s)
+	return context.WithValue(ctx, testSpanKey{}, s), s
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) {
+	s.attrs[key] = value
+}
+
+func (s *testSpan) End(err error) {
+	s.err = err
+	s.ended = true
+}
+
+func TestTracerSpan(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	tracer := &testTracer{}
+	require.NoError(t, client.parseOptions(WithTracer(tracer)))
+
+	var spanInRequest *testSpan
+	mux.HandleFunc(apiVerPath+"domains/test.com/renew", func(w http.ResponseWriter, r *http.Request) {
+		writeFixture(t, w, "renew.json")
+	})
+	require.NoError(t, client.parseOptions(WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			spanInRequest, _ = req.Context().Value(testSpanKey{}).(*testSpan)
+			return next.Do(req)
+		})
+	})))
+
+	parent := &testSpan{operation: "job"}
+	ctx := context.WithValue(context.Background(), testSpanKey{}, parent)
+	_, err := client.RenewDomain("test.com", &RenewDomainOptions{Period: "1"}, WithContext(ctx))
+	require.NoError(t, err)
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	require.Equal(t, "RenewDomain", span.operation)
+	require.Equal(t, parent, span.parent)
+	require.Equal(t, span, spanInRequest)
+	require.True(t, span.ended)
+	require.NoError(t, span.err)
+	require.Equal(t, map[string]interface{}{
+		AttrOperation:  "RenewDomain",
+		AttrMethod:     http.MethodPut,
+		AttrDomain:     "test.com",
+		AttrStatusCode: http.StatusOK,
+	}, span.attrs)
+}
+
+func TestTracerSpanError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	tracer := &testTracer{}
+	require.NoError(t, client.parseOptions(WithTracer(tracer)))
+
+	mux.HandleFunc(apiVerPath+"account/balance", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errors":[{"code":401,"message":"Unauthorized"}]}`))
+	})
+
+	_, err := client.GetAccountBalance()
+	require.Error(t, err)
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	require.Equal(t, "GetAccountBalance", span.operation)
+	require.Equal(t, err, span.err)
+	require.Equal(t, http.StatusUnauthorized, span.attrs[AttrStatusCode])
+	require.Equal(t, 401, span.attrs[AttrAPIErrorCode])
+	require.NotContains(t, span.attrs, AttrDomain)
+}
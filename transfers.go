@@ -1,6 +1,7 @@
 package pananames
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -9,9 +10,9 @@ import (
 // Represents a transfer in info
 type TransferIn struct {
 	Domain            string              `json:"domain"`
-	TransferStatus    string              `json:"transfer_status"`
+	TransferStatus    TransferStatus      `json:"transfer_status"`
 	InitDate          *PnTime             `json:"init_date"`
-	PremiumPrice      float64             `json:"premium_price"`
+	PremiumPrice      Money               `json:"premium_price"`
 	WhoisPrivacy      bool                `json:"whois_privacy"`
 	RegistrantContact *Contact            `json:"registrant_contact"`
 	AdminContact      *Contact            `json:"admin_contact"`
@@ -25,7 +26,7 @@ type TransferIn struct {
 type InitTransferInOptions struct {
 	Domain            string              `json:"domain,omitempty"`
 	AuthCode          string              `json:"auth_code,omitempty"`
-	PremiumPrice      float64             `json:"premium_price,omitempty"`
+	PremiumPrice      *Money              `json:"premium_price,omitempty"`
 	WhoisPrivacy      bool                `json:"whois_privacy"`
 	RegistrantContact *Contact            `json:"registrant_contact,omitempty"`
 	AdminContact      *Contact            `json:"admin_contact,omitempty"`
@@ -38,8 +39,8 @@ type InitTransferInOptions struct {
 // Available options for GetTransfersIn()
 type GetTransfersInOptions struct {
 	ListOptions
-	DomainLike string `url:"domain_like,omitempty"`
-	Status     string `url:"status,omitempty"`
+	DomainLike string         `url:"domain_like,omitempty"`
+	Status     TransferStatus `url:"status,omitempty"`
 }
 
 // Available options for CancelTransferIn()
@@ -47,16 +48,59 @@ type CancelTransferInOptions struct {
 	Domain string `json:"domain,omitempty"`
 }
 
+// Validate GetTransfersInOptions filters
+func (opt *GetTransfersInOptions) Validate() error {
+	if opt == nil {
+		return nil
+	}
+	var e fieldErrors
+	e.list(opt.ListOptions)
+	return e.err(opt)
+}
+
+// Validate InitTransferInOptions for domain name, auth code and name servers
+func (opt *InitTransferInOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	e.domain("domain", opt.Domain)
+	if opt.AuthCode == "" {
+		e.add("auth_code", "is required")
+	}
+	e.price("premium_price", opt.PremiumPrice)
+	e.contact("registrant_contact", opt.RegistrantContact, false)
+	e.contact("admin_contact", opt.AdminContact, false)
+	e.contact("tech_contact", opt.TechContact, false)
+	e.contact("billing_contact", opt.BillingContact, false)
+	if opt.NameServers != nil {
+		for i, ns := range *opt.NameServers {
+			e.hostname(fmt.Sprintf("name_servers[%d]", i), ns)
+		}
+	}
+	return e.err(opt)
+}
+
+// Validate CancelTransferInOptions for domain name
+func (opt *CancelTransferInOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
+	}
+	var e fieldErrors
+	e.domain("domain", opt.Domain)
+	return e.err(opt)
+}
+
 // Get paged list of active transfers in
 func (c *Client) GetTransfersIn(opt *GetTransfersInOptions, options ...RequestOptionFunc) ([]*TransferIn, *Pagination, error) {
-	u := "transfers_in"
-	req, err := c.NewRequest(http.MethodGet, u, opt, options)
-	if err != nil {
-		return nil, nil, err
-	}
+	return c.GetTransfersInCtx(context.Background(), opt, options...)
+}
 
-	var result []*TransferIn
-	resp, err := c.Do(req, &result)
+// Same as GetTransfersIn() with the request context
+func (c *Client) GetTransfersInCtx(ctx context.Context, opt *GetTransfersInOptions, options ...RequestOptionFunc) ([]*TransferIn, *Pagination, error) {
+	u := "transfers_in"
+	options = append([]RequestOptionFunc{operation("GetTransfersIn", "")}, options...)
+	result, resp, err := call[[]*TransferIn](c, ctx, http.MethodGet, u, opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,67 +111,79 @@ func (c *Client) GetTransfersIn(opt *GetTransfersInOptions, options ...RequestOp
 // Initiate transfer in process for domain
 // You should provide correct WHOIS information
 func (c *Client) InitTransferIn(opt *InitTransferInOptions, options ...RequestOptionFunc) (*TransferIn, error) {
+	return c.InitTransferInCtx(context.Background(), opt, options...)
+}
+
+// Same as InitTransferIn() with the request context
+func (c *Client) InitTransferInCtx(ctx context.Context, opt *InitTransferInOptions, options ...RequestOptionFunc) (*TransferIn, error) {
 	u := "transfers_in"
-	req, err := c.NewRequest(http.MethodPost, u, opt, options)
-	if err != nil {
-		return nil, err
+	var domain string
+	if opt != nil {
+		domain = opt.Domain
+		if err := c.checkTLD(opt.Domain); err != nil {
+			return nil, err
+		}
+		ascii, err := c.asciiDomain(opt.Domain)
+		if err != nil {
+			return nil, err
+		}
+		if ascii != opt.Domain {
+			converted := *opt
+			converted.Domain = ascii
+			opt = &converted
+		}
 	}
-
-	result := new(TransferIn)
-	_, err = c.Do(req, &result)
+	release, err := c.guardBalance(ctx, opt, domain, QuoteTransfer, 1)
 	if err != nil {
 		return nil, err
 	}
-
-	return result, nil
+	options = append([]RequestOptionFunc{operation("InitTransferIn", domain)}, options...)
+	result, err := Call[*TransferIn](c, ctx, http.MethodPost, u, opt, options...)
+	release(err)
+	return result, err
 }
 
 // Cancel transfer in process for domain
 func (c *Client) CancelTransferIn(opt *CancelTransferInOptions, options ...RequestOptionFunc) error {
-	u := "transfers_in"
-	req, err := c.NewRequest(http.MethodDelete, u, opt, options)
-	if err != nil {
-		return err
-	}
+	return c.CancelTransferInCtx(context.Background(), opt, options...)
+}
 
-	_, err = c.Do(req, nil)
-	if err != nil {
-		return err
+// Same as CancelTransferIn() with the request context
+func (c *Client) CancelTransferInCtx(ctx context.Context, opt *CancelTransferInOptions, options ...RequestOptionFunc) error {
+	u := "transfers_in"
+	var domain string
+	if opt != nil {
+		domain = opt.Domain
 	}
-
+	options = append([]RequestOptionFunc{operation("CancelTransferIn", domain)}, options...)
+	_, err := Call[struct{}](c, ctx, http.MethodDelete, u, opt, options...)
 	return err
 }
 
 // Prepare a domain for transferring out
 // This will unlock a domain and send the authorization code to the domain’s registrant email
 func (c *Client) InitTransferOut(domain string, options ...RequestOptionFunc) error {
-	u := fmt.Sprintf("domains/%s/transfer_out", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, nil, options)
-	if err != nil {
-		return err
-	}
-
-	_, err = c.Do(req, nil)
-	if err != nil {
-		return err
-	}
+	return c.InitTransferOutCtx(context.Background(), domain, options...)
+}
 
+// Same as InitTransferOut() with the request context
+func (c *Client) InitTransferOutCtx(ctx context.Context, domain string, options ...RequestOptionFunc) error {
+	u := fmt.Sprintf("domains/%s/transfer_out", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("InitTransferOut", domain), nonIdempotent()}, options...)
+	_, err := Call[struct{}](c, ctx, http.MethodPut, u, nil, options...)
 	return err
 }
 
 // Cancel transfer out process for domain
 // Domain will be locked again
 func (c *Client) CancelTransferOut(domain string, options ...RequestOptionFunc) error {
-	u := fmt.Sprintf("domains/%s/transfer_out", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodDelete, u, nil, options)
-	if err != nil {
-		return err
-	}
-
-	_, err = c.Do(req, nil)
-	if err != nil {
-		return err
-	}
+	return c.CancelTransferOutCtx(context.Background(), domain, options...)
+}
 
+// Same as CancelTransferOut() with the request context
+func (c *Client) CancelTransferOutCtx(ctx context.Context, domain string, options ...RequestOptionFunc) error {
+	u := fmt.Sprintf("domains/%s/transfer_out", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("CancelTransferOut", domain)}, options...)
+	_, err := Call[struct{}](c, ctx, http.MethodDelete, u, nil, options...)
 	return err
 }
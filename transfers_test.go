@@ -12,7 +12,7 @@ var wantTransferInInfo = &TransferIn{
 	Domain:            "test.com",
 	TransferStatus:    "waiting registrant confirmation",
 	InitDate:          &PnTime{wantDate},
-	PremiumPrice:      123,
+	PremiumPrice:      MustParseMoney("123", ""),
 	WhoisPrivacy:      true,
 	RegistrantContact: wantContact,
 	AdminContact:      wantContact,
@@ -48,16 +48,17 @@ func TestInitTransfersIn(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
 
+	premium := MustParseMoney("123", "")
 	opts := &InitTransferInOptions{
 		Domain:            "test.com",
 		AuthCode:          "123",
-		PremiumPrice:      123,
+		PremiumPrice:      &premium,
 		WhoisPrivacy:      true,
-		RegistrantContact: wantContact,
-		AdminContact:      wantContact,
-		TechContact:       wantContact,
-		BillingContact:    wantContact,
-		NameServers:       &NameServers{"string"},
+		RegistrantContact: validContact,
+		AdminContact:      validContact,
+		TechContact:       validContact,
+		BillingContact:    validContact,
+		NameServers:       &NameServers{"ns1.test.com"},
 		NameServerRecords: []*NameServerRecord{{
 			ID:       "string",
 			Name:     "string",
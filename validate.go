@@ -0,0 +1,192 @@
+package pananames
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Represents options checked by the client before sending
+type validator interface {
+	Validate() error
+}
+
+// Represents an invalid field of request options
+// Field is the JSON or query name of the field, nested fields are joined with a dot
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Represents all invalid fields of request options, it matches ErrValidation
+type ValidationError struct {
+	Options string
+	Fields  []*FieldError
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+func (e *ValidationError) Error() string {
+	var msgs []string
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Error())
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Options, strings.Join(msgs, "; "))
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := []error{ErrValidation}
+	for _, f := range e.Fields {
+		errs = append(errs, f)
+	}
+	return errs
+}
+
+// WithValidation Set whether request options are validated before sending, enabled by default
+func WithValidation(enabled bool) Option {
+	return func(c *Client) error {
+		c.noValidation = !enabled
+		return nil
+	}
+}
+
+// Validate request options if they support it and validation is enabled
+func (c *Client) validate(opt interface{}) error {
+	if c.noValidation {
+		return nil
+	}
+	if v, ok := opt.(validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// Represents invalid fields collected during validation
+type fieldErrors []*FieldError
+
+func (e *fieldErrors) add(field, format string, args ...interface{}) {
+	*e = append(*e, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Error for the options, nil if no fields are invalid
+func (e fieldErrors) err(opt interface{}) error {
+	if len(e) == 0 {
+		return nil
+	}
+	return &ValidationError{Options: fmt.Sprintf("%T", opt), Fields: e}
+}
+
+// Error for nil options
+func nilOptionsError(opt interface{}) error {
+	var e fieldErrors
+	e.add("", "can't be nil")
+	return e.err(opt)
+}
+
+func (e *fieldErrors) domain(field, domain string) {
+	if domain == "" {
+		e.add(field, "is required")
+	} else if !validDomainName(domain) {
+		e.add(field, "%q is not a valid domain name", domain)
+	}
+}
+
+func (e *fieldErrors) hostname(field, host string) {
+	if host == "" {
+		e.add(field, "is required")
+	} else if !validDomainName(host) {
+		e.add(field, "%q is not a valid host name", host)
+	}
+}
+
+func (e *fieldErrors) period(field string, period int) {
+	if period < 1 || period > 10 {
+		e.add(field, "must be from 1 to 10 years, got %d", period)
+	}
+}
+
+func (e *fieldErrors) price(field string, price *Money) {
+	if price != nil && price.Sign() < 0 {
+		e.add(field, "can't be negative")
+	}
+}
+
+func (e *fieldErrors) contact(field string, contact *Contact, required bool) {
+	if contact == nil {
+		if required {
+			e.add(field, "is required")
+		}
+		return
+	}
+	contact.validate(e, field+".")
+}
+
+func (e *fieldErrors) list(opt ListOptions) {
+	if opt.Limit < 0 {
+		e.add("per_page", "can't be negative")
+	}
+	if opt.Page < 0 {
+		e.add("current_page", "can't be negative")
+	}
+}
+
+func (e *fieldErrors) date(field, date string) {
+	if date == "" {
+		return
+	}
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		e.add(field, "%q is not a date in YYYY-MM-DD format", date)
+	}
+}
+
+func (e *fieldErrors) enum(field string, value string, valid bool) {
+	if value != "" && !valid {
+		e.add(field, "unknown value %q", value)
+	}
+}
+
+// Check syntax of the domain or host name, internationalized labels are allowed
+func validDomainName(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if len(name) > 253 {
+		return false
+	}
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, r := range label {
+			if r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Check the URL is absolute http or https URL
+func validURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// Check the IP address has the expected version
+func validIP(s string, v6 bool) bool {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return false
+	}
+	return (ip.To4() == nil) == v6
+}
@@ -0,0 +1,121 @@
+package pananames
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// valid options for RegisterDomain()
+func newRegisterOptions(domain string) *RegisterDomainOptions {
+	return &RegisterDomainOptions{
+		Domain:            domain,
+		Period:            1,
+		RegistrantContact: validContact,
+		AdminContact:      validContact,
+		TechContact:       validContact,
+		BillingContact:    validContact,
+	}
+}
+
+// field names of the validation error
+func invalidFields(t *testing.T, err error) []string {
+	t.Helper()
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr), "unexpected error %v", err)
+	var fields []string
+	for _, f := range verr.Fields {
+		fields = append(fields, f.Field)
+	}
+	return fields
+}
+
+func TestValidateRegisterDomainOptions(t *testing.T) {
+	require.NoError(t, newRegisterOptions("test.com").Validate())
+	require.NoError(t, newRegisterOptions("тест.рф").Validate())
+
+	err := (&RegisterDomainOptions{Domain: "-bad.com", Period: 11, PremiumPrice: &Money{micros: -1}, AdminContact: validContact}).Validate()
+	require.ErrorIs(t, err, ErrValidation)
+	require.Equal(t, []string{"domain", "period", "premium_price", "registrant_contact", "tech_contact", "billing_contact"}, invalidFields(t, err))
+
+	var opt *RegisterDomainOptions
+	require.EqualError(t, opt.Validate(), "invalid *pananames.RegisterDomainOptions: can't be nil")
+}
+
+func TestValidateOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		opt    validator
+		fields []string
+	}{
+		{"renew", &RenewDomainOptions{Period: "2"}, nil},
+		{"renew period", &RenewDomainOptions{Period: "two"}, []string{"period"}},
+		{"renew nil", (*RenewDomainOptions)(nil), []string{""}},
+		{"transfer in", &InitTransferInOptions{Domain: "test.com", AuthCode: "code"}, nil},
+		{"transfer in auth code", &InitTransferInOptions{Domain: "test", NameServers: &NameServers{"ns1..com"}}, []string{"domain", "auth_code", "name_servers[0]"}},
+		{"cancel transfer in", &CancelTransferInOptions{}, []string{"domain"}},
+		{"child name server", &ChildNameServerOptions{Hostname: "ns1.test.com", IPv6: "2001:db8::1"}, nil},
+		{"child name server ip", &ChildNameServerOptions{Hostname: "ns1", IPv4: "2001:db8::1", IPv6: "192.0.2.1"}, []string{"hostname", "ipv4", "ipv6"}},
+		{"child name server no ip", &ChildNameServerOptions{Hostname: "ns1.test.com"}, []string{"ipv4"}},
+		{"delete child name server", &DeleteChildNameServerOptions{}, []string{"hostname"}},
+		{"delete record", &DeleteNameServerRecordsOptions{}, []string{"id"}},
+		{"dnssec", &EnableDNSSecOptions{}, []string{"ds"}},
+		{"name servers", &SetNameServersOptions{NameServers: NameServers{"ns1.test.com", "ns2"}}, []string{"name_servers[1]"}},
+		{"name servers empty", &SetNameServersOptions{}, []string{"name_servers"}},
+		{"redirect", &EnableDomainRedirectOptions{Url: "https://example.com/path"}, nil},
+		{"redirect url", &EnableDomainRedirectOptions{Url: "example.com"}, []string{"url"}},
+		{"bulk redirect", &EnableBulkDomainRedirectOptions{DomainList: []string{"test.com", "bad_domain.com"}}, []string{"url", "domain_list[1]"}},
+		{"bulk check", &CheckDomainsBulkOptions{Domains: []string{"test.com", "test"}}, []string{"domains[1]"}},
+		{"whois nil", (*UpdateWhoisInfoOptions)(nil), []string{""}},
+		{"domains nil", (*GetDomainsOptions)(nil), nil},
+		// values missing from the known lists are passed to the API
+		{"domains status", &GetDomainsOptions{Status: "pendingDelete", LockStatus: LockStatusLocked}, nil},
+		{"domains page", &GetDomainsOptions{ListOptions: ListOptions{Limit: -1, Page: -1}}, []string{"per_page", "current_page"}},
+		{"transfers status", &GetTransfersInOptions{Status: "done"}, nil},
+		{"emails status", &GetEmailsOptions{Status: DomainStateOK, EmailStatus: "bounced"}, nil},
+		{"payments", &GetAccountPaymentsOptions{PayType: PaymentTypeRenew, DateFrom: "2024-01-01"}, nil},
+		{"payments filters", &GetAccountPaymentsOptions{PayType: "renewal", DateEnd: "01.01.2024"}, []string{"date_end"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opt.Validate()
+			if tt.fields == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrValidation)
+			require.Equal(t, tt.fields, invalidFields(t, err))
+		})
+	}
+}
+
+func TestClientValidation(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var calls int32
+	mux.HandleFunc(apiVerPath+"domains", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writeFixture(t, w, "domain.json")
+	})
+
+	_, err := client.RegisterDomain(&RegisterDomainOptions{Domain: "test.com"})
+	require.ErrorIs(t, err, ErrValidation)
+	var fieldErr *FieldError
+	require.True(t, errors.As(err, &fieldErr))
+	require.Equal(t, "period", fieldErr.Field)
+	require.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	_, err = client.CheckDomainsBulk(&CheckDomainsBulkOptions{Domains: []string{"test"}})
+	require.ErrorIs(t, err, ErrValidation)
+
+	// validation is disabled
+	require.NoError(t, client.parseOptions(WithValidation(false)))
+	_, err = client.RegisterDomain(&RegisterDomainOptions{Domain: "test.com"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
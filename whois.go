@@ -1,6 +1,7 @@
 package pananames
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -35,34 +36,42 @@ type UpdateWhoisInfoOptions struct {
 	BillingContact    *Contact `json:"billing_contact,omitempty"`
 }
 
-// Get WHOIS information for the domain. It works only for your domains
-func (c *Client) GetWhoisInfo(domain string, opt *GetWhoisInfoOptions, options ...RequestOptionFunc) (*WhoisInfo, error) {
-	u := fmt.Sprintf("domains/%s/whois", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodGet, u, opt, options)
-	if err != nil {
-		return nil, err
+// Validate UpdateWhoisInfoOptions contacts
+func (opt *UpdateWhoisInfoOptions) Validate() error {
+	if opt == nil {
+		return nilOptionsError(opt)
 	}
+	var e fieldErrors
+	e.contact("registrant_contact", opt.RegistrantContact, false)
+	e.contact("admin_contact", opt.AdminContact, false)
+	e.contact("tech_contact", opt.TechContact, false)
+	e.contact("billing_contact", opt.BillingContact, false)
+	return e.err(opt)
+}
 
-	result := new(WhoisInfo)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+// Get WHOIS information for the domain. It works only for your domains
+func (c *Client) GetWhoisInfo(domain string, opt *GetWhoisInfoOptions, options ...RequestOptionFunc) (*WhoisInfo, error) {
+	return c.GetWhoisInfoCtx(context.Background(), domain, opt, options...)
+}
 
-	return result, nil
+// Same as GetWhoisInfo() with the request context
+func (c *Client) GetWhoisInfoCtx(ctx context.Context, domain string, opt *GetWhoisInfoOptions, options ...RequestOptionFunc) (*WhoisInfo, error) {
+	u := fmt.Sprintf("domains/%s/whois", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("GetWhoisInfo", domain)}, options...)
+	return Call[*WhoisInfo](c, ctx, http.MethodGet, u, opt, options...)
 }
 
 // Update WHOIS information for the domain
 // Return notice if confirmation is needed as second param
 func (c *Client) UpdateWhoisInfo(domain string, opt *UpdateWhoisInfoOptions, options ...RequestOptionFunc) (*WhoisInfo, string, error) {
-	u := fmt.Sprintf("domains/%s/whois", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, opt, options)
-	if err != nil {
-		return nil, "", err
-	}
+	return c.UpdateWhoisInfoCtx(context.Background(), domain, opt, options...)
+}
 
-	result := new(WhoisInfo)
-	resp, err := c.Do(req, result)
+// Same as UpdateWhoisInfo() with the request context
+func (c *Client) UpdateWhoisInfoCtx(ctx context.Context, domain string, opt *UpdateWhoisInfoOptions, options ...RequestOptionFunc) (*WhoisInfo, string, error) {
+	u := fmt.Sprintf("domains/%s/whois", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("UpdateWhoisInfo", domain)}, options...)
+	result, resp, err := call[*WhoisInfo](c, ctx, http.MethodPut, u, opt, options)
 	if err != nil {
 		return nil, "", err
 	}
@@ -72,50 +81,37 @@ func (c *Client) UpdateWhoisInfo(domain string, opt *UpdateWhoisInfoOptions, opt
 
 // Get WHOIS privacy status for the domain
 func (c *Client) GetWhoisPrivacy(domain string, options ...RequestOptionFunc) (*WhoisPrivacy, error) {
-	u := fmt.Sprintf("domains/%s/whois_privacy", url.PathEscape(domain))
-
-	req, err := c.NewRequest(http.MethodGet, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetWhoisPrivacyCtx(context.Background(), domain, options...)
+}
 
-	result := new(WhoisPrivacy)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+// Same as GetWhoisPrivacy() with the request context
+func (c *Client) GetWhoisPrivacyCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*WhoisPrivacy, error) {
+	u := fmt.Sprintf("domains/%s/whois_privacy", url.PathEscape(domain))
 
-	return result, nil
+	options = append([]RequestOptionFunc{operation("GetWhoisPrivacy", domain)}, options...)
+	return Call[*WhoisPrivacy](c, ctx, http.MethodGet, u, nil, options...)
 }
 
 // Enable WHOIS privacy of the domain
 func (c *Client) EnableWhoisPrivacy(domain string, options ...RequestOptionFunc) (*WhoisPrivacy, error) {
-	u := fmt.Sprintf("domains/%s/whois_privacy", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodPut, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-	result := new(WhoisPrivacy)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.EnableWhoisPrivacyCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as EnableWhoisPrivacy() with the request context
+func (c *Client) EnableWhoisPrivacyCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*WhoisPrivacy, error) {
+	u := fmt.Sprintf("domains/%s/whois_privacy", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("EnableWhoisPrivacy", domain)}, options...)
+	return Call[*WhoisPrivacy](c, ctx, http.MethodPut, u, nil, options...)
 }
 
 // Disable WHOIS privacy of the domain
 func (c *Client) DisableWhoisPrivacy(domain string, options ...RequestOptionFunc) (*WhoisPrivacy, error) {
-	u := fmt.Sprintf("domains/%s/whois_privacy", url.PathEscape(domain))
-	req, err := c.NewRequest(http.MethodDelete, u, nil, options)
-	if err != nil {
-		return nil, err
-	}
-	result := new(WhoisPrivacy)
-	_, err = c.Do(req, result)
-	if err != nil {
-		return nil, err
-	}
+	return c.DisableWhoisPrivacyCtx(context.Background(), domain, options...)
+}
 
-	return result, nil
+// Same as DisableWhoisPrivacy() with the request context
+func (c *Client) DisableWhoisPrivacyCtx(ctx context.Context, domain string, options ...RequestOptionFunc) (*WhoisPrivacy, error) {
+	u := fmt.Sprintf("domains/%s/whois_privacy", url.PathEscape(domain))
+	options = append([]RequestOptionFunc{operation("DisableWhoisPrivacy", domain)}, options...)
+	return Call[*WhoisPrivacy](c, ctx, http.MethodDelete, u, nil, options...)
 }
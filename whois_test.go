@@ -57,10 +57,10 @@ func TestUpdateWhoisInfo(t *testing.T) {
 	defer teardown(server)
 
 	opts := &UpdateWhoisInfoOptions{
-		RegistrantContact: wantContact,
-		AdminContact:      wantContact,
-		TechContact:       wantContact,
-		BillingContact:    wantContact,
+		RegistrantContact: validContact,
+		AdminContact:      validContact,
+		TechContact:       validContact,
+		BillingContact:    validContact,
 	}
 	mux.HandleFunc(apiVerPath+"domains/test.com/whois", func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodPut, r.Method)